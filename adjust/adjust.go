@@ -0,0 +1,117 @@
+//Package adjust adjusts historical prices for splits and dividends locally
+package adjust
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	iex "github.com/Z-M-Huang/go-iex"
+)
+
+const dateLayout = "2006-01-02"
+
+//Options corporate actions applied by Adjust
+type Options struct {
+	Splits    []*iex.Split
+	Dividends []*iex.Dividend
+	//AsOf ignore events with an ex-date after AsOf, producing the series as it looked on that date. Zero applies all events.
+	AsOf time.Time
+}
+
+//SplitAdjusted adjust prices for splits only
+func SplitAdjusted(prices []*iex.HistoricalPrice, splits []*iex.Split) ([]*iex.HistoricalPrice, error) {
+	return Adjust(prices, Options{Splits: splits})
+}
+
+//TotalReturn adjust prices for splits and dividends
+func TotalReturn(prices []*iex.HistoricalPrice, splits []*iex.Split, dividends []*iex.Dividend) ([]*iex.HistoricalPrice, error) {
+	return Adjust(prices, Options{Splits: splits, Dividends: dividends})
+}
+
+//Adjust compute Open, High, Low, Close and Volume from the unadjusted UOpen, UHigh, ULow, UClose and UVolume.
+//Prices before an event's ex-date are multiplied by its factor: the split ratio, and for dividends
+//1 - amount / the unadjusted close of the last trading day before the ex-date.
+//The input is not modified, the result is in the same order as prices.
+func Adjust(prices []*iex.HistoricalPrice, opts Options) ([]*iex.HistoricalPrice, error) {
+	dates := make([]time.Time, len(prices))
+	for i, p := range prices {
+		d, err := time.Parse(dateLayout, p.Date)
+		if err != nil {
+			return nil, fmt.Errorf("price %d: %w", i, err)
+		}
+		dates[i] = d
+	}
+	//Indexes of prices in date order, to find the close before a dividend's ex-date
+	order := make([]int, len(prices))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return dates[order[i]].Before(dates[order[j]])
+	})
+
+	type event struct {
+		exDate      time.Time
+		priceFactor float64
+		splitFactor float64
+	}
+	var events []event
+	for _, s := range opts.Splits {
+		exDate, err := time.Parse(dateLayout, s.ExDate)
+		if err != nil {
+			return nil, fmt.Errorf("split %s: %w", s.Symbol, err)
+		}
+		ratio := s.Ratio
+		if ratio == 0 && s.ToFactor != 0 {
+			ratio = s.FromFactor / s.ToFactor
+		}
+		if ratio <= 0 {
+			return nil, fmt.Errorf("split %s on %s: invalid ratio", s.Symbol, s.ExDate)
+		}
+		events = append(events, event{exDate: exDate, priceFactor: ratio, splitFactor: ratio})
+	}
+	for _, d := range opts.Dividends {
+		exDate, err := time.Parse(dateLayout, d.ExDate)
+		if err != nil {
+			return nil, fmt.Errorf("dividend %s: %w", d.Symbol, err)
+		}
+		prevClose := 0.0
+		for _, i := range order {
+			if !dates[i].Before(exDate) {
+				break
+			}
+			prevClose = prices[i].UClose
+		}
+		//Without a close before the ex-date no price in the series is affected
+		if prevClose == 0 {
+			continue
+		}
+		factor := 1 - d.Amount/prevClose
+		if factor <= 0 {
+			return nil, fmt.Errorf("dividend %s on %s: amount %v exceeds previous close %v", d.Symbol, d.ExDate, d.Amount, prevClose)
+		}
+		events = append(events, event{exDate: exDate, priceFactor: factor, splitFactor: 1})
+	}
+
+	ret := make([]*iex.HistoricalPrice, len(prices))
+	for i, p := range prices {
+		priceFactor, splitFactor := 1.0, 1.0
+		for _, e := range events {
+			if !dates[i].Before(e.exDate) || (!opts.AsOf.IsZero() && e.exDate.After(opts.AsOf)) {
+				continue
+			}
+			priceFactor *= e.priceFactor
+			splitFactor *= e.splitFactor
+		}
+		adjusted := *p
+		adjusted.Open = p.UOpen * priceFactor
+		adjusted.High = p.UHigh * priceFactor
+		adjusted.Low = p.ULow * priceFactor
+		adjusted.Close = p.UClose * priceFactor
+		adjusted.Volume = int(math.Round(float64(p.UVolume) / splitFactor))
+		ret[i] = &adjusted
+	}
+	return ret, nil
+}
@@ -0,0 +1,157 @@
+package adjust
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	iex "github.com/Z-M-Huang/go-iex"
+)
+
+func testPrices() []*iex.HistoricalPrice {
+	var prices []*iex.HistoricalPrice
+	for _, p := range []struct {
+		date   string
+		close  float64
+		volume int
+	}{
+		{"2020-01-02", 100, 1000},
+		{"2020-01-03", 102, 1200},
+		{"2020-01-06", 51, 2000},
+		{"2020-01-07", 50, 2400},
+		{"2020-01-08", 49, 2200},
+	} {
+		prices = append(prices, &iex.HistoricalPrice{
+			Date:    p.date,
+			UOpen:   p.close + 1,
+			UHigh:   p.close + 2,
+			ULow:    p.close - 2,
+			UClose:  p.close,
+			UVolume: p.volume,
+		})
+	}
+	return prices
+}
+
+func TestAdjust(t *testing.T) {
+	splits := []*iex.Split{{Symbol: "TEST", ExDate: "2020-01-06", ToFactor: 2, FromFactor: 1}}
+	dividends := []*iex.Dividend{{Symbol: "TEST", ExDate: "2020-01-08", Amount: 1}}
+	tests := []struct {
+		name       string
+		opts       Options
+		wantClose  []float64
+		wantVolume []int
+		wantErr    bool
+	}{
+		{
+			name:       "No events",
+			opts:       Options{},
+			wantClose:  []float64{100, 102, 51, 50, 49},
+			wantVolume: []int{1000, 1200, 2000, 2400, 2200},
+		},
+		{
+			name:       "Split only",
+			opts:       Options{Splits: splits},
+			wantClose:  []float64{50, 51, 51, 50, 49},
+			wantVolume: []int{2000, 2400, 2000, 2400, 2200},
+		},
+		{
+			name:       "Total return",
+			opts:       Options{Splits: splits, Dividends: dividends},
+			wantClose:  []float64{49, 49.98, 49.98, 49, 49},
+			wantVolume: []int{2000, 2400, 2000, 2400, 2200},
+		},
+		{
+			name:       "As of before dividend",
+			opts:       Options{Splits: splits, Dividends: dividends, AsOf: time.Date(2020, 1, 7, 0, 0, 0, 0, time.UTC)},
+			wantClose:  []float64{50, 51, 51, 50, 49},
+			wantVolume: []int{2000, 2400, 2000, 2400, 2200},
+		},
+		{
+			name:       "As of before split",
+			opts:       Options{Splits: splits, Dividends: dividends, AsOf: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)},
+			wantClose:  []float64{100, 102, 51, 50, 49},
+			wantVolume: []int{1000, 1200, 2000, 2400, 2200},
+		},
+		{
+			name:       "Dividend before series",
+			opts:       Options{Dividends: []*iex.Dividend{{ExDate: "2019-12-31", Amount: 1}}},
+			wantClose:  []float64{100, 102, 51, 50, 49},
+			wantVolume: []int{1000, 1200, 2000, 2400, 2200},
+		},
+		{
+			name:    "Invalid split",
+			opts:    Options{Splits: []*iex.Split{{ExDate: "2020-01-06"}}},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid ex-date",
+			opts:    Options{Dividends: []*iex.Dividend{{ExDate: "01/06/2020", Amount: 1}}},
+			wantErr: true,
+		},
+		{
+			name:    "Dividend exceeds close",
+			opts:    Options{Dividends: []*iex.Dividend{{ExDate: "2020-01-08", Amount: 50}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prices := testPrices()
+			got, err := Adjust(prices, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Adjust() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(prices, testPrices()) {
+				t.Errorf("Adjust() modified its input")
+			}
+			for i, p := range got {
+				factor := tt.wantClose[i] / prices[i].UClose
+				if math.Abs(p.Close-tt.wantClose[i]) > 1e-9 || math.Abs(p.Open-prices[i].UOpen*factor) > 1e-9 ||
+					math.Abs(p.High-prices[i].UHigh*factor) > 1e-9 || math.Abs(p.Low-prices[i].ULow*factor) > 1e-9 {
+					t.Errorf("Adjust()[%d] = %+v, want close %v", i, p, tt.wantClose[i])
+				}
+				if p.Volume != tt.wantVolume[i] {
+					t.Errorf("Adjust()[%d].Volume = %v, want %v", i, p.Volume, tt.wantVolume[i])
+				}
+				if p.UClose != prices[i].UClose || p.Date != prices[i].Date {
+					t.Errorf("Adjust()[%d] changed unadjusted fields", i)
+				}
+			}
+		})
+	}
+}
+
+func TestSplitAdjustedAndTotalReturn(t *testing.T) {
+	//Newest first, as returned by Client.HistoricalPrice
+	prices := testPrices()
+	for i, j := 0, len(prices)-1; i < j; i, j = i+1, j-1 {
+		prices[i], prices[j] = prices[j], prices[i]
+	}
+	splits := []*iex.Split{{ExDate: "2020-01-06", Ratio: 0.5}}
+	dividends := []*iex.Dividend{{ExDate: "2020-01-08", Amount: 1}}
+
+	got, err := SplitAdjusted(prices, splits)
+	if err != nil {
+		t.Fatalf("SplitAdjusted() error = %v", err)
+	}
+	if got[4].Close != 50 || got[0].Close != 49 {
+		t.Errorf("SplitAdjusted() closes = %v, %v, want 50, 49", got[4].Close, got[0].Close)
+	}
+
+	got, err = TotalReturn(prices, splits, dividends)
+	if err != nil {
+		t.Fatalf("TotalReturn() error = %v", err)
+	}
+	if math.Abs(got[4].Close-49) > 1e-9 || got[0].Close != 49 {
+		t.Errorf("TotalReturn() closes = %v, %v, want 49, 49", got[4].Close, got[0].Close)
+	}
+
+	if _, err := TotalReturn([]*iex.HistoricalPrice{{Date: "bad"}}, nil, nil); err == nil {
+		t.Errorf("TotalReturn() error = nil for invalid date")
+	}
+}
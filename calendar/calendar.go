@@ -0,0 +1,274 @@
+//Package calendar computes US equity market sessions, holidays and early closes offline
+package calendar
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	//Embed the time zone database so America/New_York loads on systems without one
+	_ "time/tzdata"
+
+	iex "github.com/Z-M-Huang/go-iex"
+	"github.com/Z-M-Huang/go-iex/enum/datetype"
+)
+
+const dateLayout = "2006-01-02"
+
+//Location America/New_York, the time zone all sessions are computed in
+var Location = mustLoadLocation("America/New_York")
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+//Session part of the trading day
+type Session int
+
+//Sessions
+const (
+	Closed Session = iota
+	PreMarket
+	Regular
+	AfterHours
+)
+
+func (s Session) String() string {
+	switch s {
+	case PreMarket:
+		return "pre-market"
+	case Regular:
+		return "regular"
+	case AfterHours:
+		return "after-hours"
+	}
+	return "closed"
+}
+
+//Hours session boundaries of a trading day in America/New_York.
+//Pre-market runs from PreMarketOpen to Open, the regular session from Open to Close and after-hours from Close to AfterHoursClose.
+type Hours struct {
+	PreMarketOpen   time.Time
+	Open            time.Time
+	Close           time.Time
+	AfterHoursClose time.Time
+	EarlyClose      bool
+}
+
+//Session the session at t, Closed outside of the trading day
+func (h Hours) Session(t time.Time) Session {
+	switch {
+	case t.Before(h.PreMarketOpen), !t.Before(h.AfterHoursClose):
+		return Closed
+	case t.Before(h.Open):
+		return PreMarket
+	case t.Before(h.Close):
+		return Regular
+	}
+	return AfterHours
+}
+
+//Calendar US equity market calendar.
+//Holidays and early closes are computed from NYSE rules; Reconcile overrides individual dates, e.g. unscheduled closures.
+//A Calendar is safe for concurrent use.
+type Calendar struct {
+	mu        sync.RWMutex
+	overrides map[string]bool
+}
+
+//New create a calendar from the NYSE rules
+func New() *Calendar {
+	return &Calendar{overrides: make(map[string]bool)}
+}
+
+//Reconcile mark dates returned by https://iexcloud.io/docs/api/#u-s-holidays-and-trading-dates as trading days or holidays.
+//dateType is datetype.Trade or datetype.Holiday, the type the dates were requested with.
+func (c *Calendar) Reconcile(dateType string, dates []*iex.USDate) error {
+	var trading bool
+	switch dateType {
+	case datetype.Trade:
+		trading = true
+	case datetype.Holiday:
+		trading = false
+	default:
+		return fmt.Errorf("unknown date type %q", dateType)
+	}
+	parsed := make([]string, 0, len(dates))
+	for _, d := range dates {
+		if d == nil {
+			continue
+		}
+		t, err := time.Parse(dateLayout, d.Date)
+		if err != nil {
+			return fmt.Errorf("date %q: %w", d.Date, err)
+		}
+		parsed = append(parsed, t.Format(dateLayout))
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, d := range parsed {
+		c.overrides[d] = trading
+	}
+	return nil
+}
+
+//Holiday the name of the holiday on t's date in New York, if the market is closed for one.
+//Dates closed by Reconcile are reported as "Market Closed".
+func (c *Calendar) Holiday(t time.Time) (string, bool) {
+	y, m, d := t.In(Location).Date()
+	c.mu.RLock()
+	trading, ok := c.overrides[time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Format(dateLayout)]
+	c.mu.RUnlock()
+	if ok {
+		if trading {
+			return "", false
+		}
+		if name, ok := holiday(y, m, d); ok {
+			return name, true
+		}
+		return "Market Closed", true
+	}
+	return holiday(y, m, d)
+}
+
+//IsTradingDay whether t's date in New York is a weekday the market is open
+func (c *Calendar) IsTradingDay(t time.Time) bool {
+	y, m, d := t.In(Location).Date()
+	c.mu.RLock()
+	trading, ok := c.overrides[time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Format(dateLayout)]
+	c.mu.RUnlock()
+	if ok {
+		return trading
+	}
+	if wd := time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Weekday(); wd == time.Saturday || wd == time.Sunday {
+		return false
+	}
+	_, closed := holiday(y, m, d)
+	return !closed
+}
+
+//Hours session boundaries of t's date in New York, false if it is not a trading day.
+//Regular hours are 9:30 a.m. to 4:00 p.m., or 1:00 p.m. on early closes, with pre-market from 4:00 a.m. and after-hours until 8:00 p.m., or 5:00 p.m. on early closes.
+func (c *Calendar) Hours(t time.Time) (Hours, bool) {
+	if !c.IsTradingDay(t) {
+		return Hours{}, false
+	}
+	y, m, d := t.In(Location).Date()
+	at := func(hour, min int) time.Time {
+		return time.Date(y, m, d, hour, min, 0, 0, Location)
+	}
+	h := Hours{
+		PreMarketOpen:   at(4, 0),
+		Open:            at(9, 30),
+		Close:           at(16, 0),
+		AfterHoursClose: at(20, 0),
+		EarlyClose:      earlyClose(y, m, d),
+	}
+	if h.EarlyClose {
+		h.Close = at(13, 0)
+		h.AfterHoursClose = at(17, 0)
+	}
+	return h, true
+}
+
+//Session the session at t
+func (c *Calendar) Session(t time.Time) Session {
+	h, ok := c.Hours(t)
+	if !ok {
+		return Closed
+	}
+	return h.Session(t)
+}
+
+//IsOpen whether the regular session is open at t
+func (c *Calendar) IsOpen(t time.Time) bool {
+	return c.Session(t) == Regular
+}
+
+//NextOpen the first regular session open after t
+func (c *Calendar) NextOpen(t time.Time) time.Time {
+	for day := t.In(Location); ; day = nextDay(day) {
+		if h, ok := c.Hours(day); ok && h.Open.After(t) {
+			return h.Open
+		}
+	}
+}
+
+//PreviousClose the last regular session close at or before t
+func (c *Calendar) PreviousClose(t time.Time) time.Time {
+	for day := t.In(Location); ; day = previousDay(day) {
+		if h, ok := c.Hours(day); ok && !h.Close.After(t) {
+			return h.Close
+		}
+	}
+}
+
+//TradingDaysBetween the number of trading days from a's date up to, but not including, b's date in New York.
+//The result is negative if b is before a.
+func (c *Calendar) TradingDaysBetween(a, b time.Time) int {
+	from, to, sign := startOfDay(a), startOfDay(b), 1
+	if to.Before(from) {
+		from, to, sign = to, from, -1
+	}
+	n := 0
+	for day := from; day.Before(to); day = nextDay(day) {
+		if c.IsTradingDay(day) {
+			n++
+		}
+	}
+	return sign * n
+}
+
+//startOfDay midnight of t's date in New York
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.In(Location).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, Location)
+}
+
+//nextDay midnight of the following date in New York, DST transitions make days 23 or 25 hours long
+func nextDay(t time.Time) time.Time {
+	y, m, d := t.In(Location).Date()
+	return time.Date(y, m, d+1, 0, 0, 0, 0, Location)
+}
+
+//previousDay the end of the previous date in New York
+func previousDay(t time.Time) time.Time {
+	y, m, d := t.In(Location).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, Location).Add(-time.Nanosecond)
+}
+
+var std = New()
+
+//IsOpen whether the regular session is open at t, using the NYSE rules
+func IsOpen(t time.Time) bool {
+	return std.IsOpen(t)
+}
+
+//IsTradingDay whether t's date in New York is a trading day, using the NYSE rules
+func IsTradingDay(t time.Time) bool {
+	return std.IsTradingDay(t)
+}
+
+//SessionAt the session at t, using the NYSE rules
+func SessionAt(t time.Time) Session {
+	return std.Session(t)
+}
+
+//NextOpen the first regular session open after t, using the NYSE rules
+func NextOpen(t time.Time) time.Time {
+	return std.NextOpen(t)
+}
+
+//PreviousClose the last regular session close at or before t, using the NYSE rules
+func PreviousClose(t time.Time) time.Time {
+	return std.PreviousClose(t)
+}
+
+//TradingDaysBetween the number of trading days from a's date up to, but not including, b's date, using the NYSE rules
+func TradingDaysBetween(a, b time.Time) int {
+	return std.TradingDaysBetween(a, b)
+}
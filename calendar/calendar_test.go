@@ -0,0 +1,179 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	iex "github.com/Z-M-Huang/go-iex"
+	"github.com/Z-M-Huang/go-iex/enum/datetype"
+)
+
+func ny(year int, month time.Month, day, hour, min int) time.Time {
+	return time.Date(year, month, day, hour, min, 0, 0, Location)
+}
+
+func TestHolidays(t *testing.T) {
+	tests := []struct {
+		year int
+		want []string
+	}{
+		{2024, []string{"2024-01-01", "2024-01-15", "2024-02-19", "2024-03-29", "2024-05-27", "2024-06-19", "2024-07-04", "2024-09-02", "2024-11-28", "2024-12-25"}},
+		{2022, []string{"2022-01-17", "2022-02-21", "2022-04-15", "2022-05-30", "2022-06-20", "2022-07-04", "2022-09-05", "2022-11-24", "2022-12-26"}},
+		{2026, []string{"2026-01-01", "2026-01-19", "2026-02-16", "2026-04-03", "2026-05-25", "2026-06-19", "2026-07-03", "2026-09-07", "2026-11-26", "2026-12-25"}},
+		{2027, []string{"2027-01-01", "2027-01-18", "2027-02-15", "2027-03-26", "2027-05-31", "2027-06-18", "2027-07-05", "2027-09-06", "2027-11-25", "2027-12-24"}},
+		{2020, []string{"2020-01-01", "2020-01-20", "2020-02-17", "2020-04-10", "2020-05-25", "2020-07-03", "2020-09-07", "2020-11-26", "2020-12-25"}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for d := ny(tt.year, time.January, 1, 12, 0); d.Year() == tt.year; d = d.AddDate(0, 0, 1) {
+			if _, ok := New().Holiday(d); ok {
+				got = append(got, d.Format(dateLayout))
+			}
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("holidays %d = %v, want %v", tt.year, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("holidays %d = %v, want %v", tt.year, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestHours(t *testing.T) {
+	c := New()
+	tests := []struct {
+		name      string
+		date      time.Time
+		wantOK    bool
+		wantClose time.Time
+		wantEarly bool
+	}{
+		{"Regular day", ny(2024, time.March, 12, 0, 0), true, ny(2024, time.March, 12, 16, 0), false},
+		{"Weekend", ny(2024, time.March, 16, 12, 0), false, time.Time{}, false},
+		{"Holiday", ny(2024, time.July, 4, 12, 0), false, time.Time{}, false},
+		{"Day after Thanksgiving", ny(2024, time.November, 29, 12, 0), true, ny(2024, time.November, 29, 13, 0), true},
+		{"Christmas Eve", ny(2024, time.December, 24, 12, 0), true, ny(2024, time.December, 24, 13, 0), true},
+		{"July 3", ny(2025, time.July, 3, 12, 0), true, ny(2025, time.July, 3, 13, 0), true},
+		{"Christmas Eve on Friday", ny(2021, time.December, 24, 12, 0), false, time.Time{}, false},
+		{"Dec 31 before Saturday New Year", ny(2021, time.December, 31, 12, 0), true, ny(2021, time.December, 31, 16, 0), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := c.Hours(tt.date)
+			if ok != tt.wantOK {
+				t.Fatalf("Calendar.Hours() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !got.Close.Equal(tt.wantClose) || got.EarlyClose != tt.wantEarly {
+				t.Errorf("Calendar.Hours() = %+v, want close %v early %v", got, tt.wantClose, tt.wantEarly)
+			}
+		})
+	}
+}
+
+func TestSession(t *testing.T) {
+	tests := []struct {
+		at   time.Time
+		want Session
+	}{
+		{ny(2024, time.March, 12, 3, 59), Closed},
+		{ny(2024, time.March, 12, 4, 0), PreMarket},
+		{ny(2024, time.March, 12, 9, 30), Regular},
+		{ny(2024, time.March, 12, 15, 59), Regular},
+		{ny(2024, time.March, 12, 16, 0), AfterHours},
+		{ny(2024, time.March, 12, 20, 0), Closed},
+		{ny(2024, time.November, 29, 14, 0), AfterHours},
+		{ny(2024, time.November, 29, 17, 0), Closed},
+		{ny(2024, time.November, 28, 12, 0), Closed},
+		//9:30 a.m. in New York is 13:30 UTC during daylight saving time
+		{time.Date(2024, time.March, 12, 13, 30, 0, 0, time.UTC), Regular},
+		{time.Date(2024, time.January, 9, 13, 30, 0, 0, time.UTC), PreMarket},
+	}
+	for _, tt := range tests {
+		if got := SessionAt(tt.at); got != tt.want {
+			t.Errorf("SessionAt(%v) = %v, want %v", tt.at, got, tt.want)
+		}
+		if got := IsOpen(tt.at); got != (tt.want == Regular) {
+			t.Errorf("IsOpen(%v) = %v", tt.at, got)
+		}
+	}
+}
+
+func TestNextOpenPreviousClose(t *testing.T) {
+	tests := []struct {
+		name      string
+		at        time.Time
+		wantOpen  time.Time
+		wantClose time.Time
+	}{
+		{"Before open", ny(2024, time.March, 12, 8, 0), ny(2024, time.March, 12, 9, 30), ny(2024, time.March, 11, 16, 0)},
+		{"During session", ny(2024, time.March, 12, 10, 0), ny(2024, time.March, 13, 9, 30), ny(2024, time.March, 11, 16, 0)},
+		{"At close", ny(2024, time.March, 12, 16, 0), ny(2024, time.March, 13, 9, 30), ny(2024, time.March, 12, 16, 0)},
+		{"Friday evening", ny(2024, time.March, 15, 18, 0), ny(2024, time.March, 18, 9, 30), ny(2024, time.March, 15, 16, 0)},
+		{"Good Friday weekend", ny(2024, time.March, 30, 12, 0), ny(2024, time.April, 1, 9, 30), ny(2024, time.March, 28, 16, 0)},
+		{"After early close", ny(2024, time.December, 24, 14, 0), ny(2024, time.December, 26, 9, 30), ny(2024, time.December, 24, 13, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NextOpen(tt.at); !got.Equal(tt.wantOpen) {
+				t.Errorf("NextOpen() = %v, want %v", got, tt.wantOpen)
+			}
+			if got := PreviousClose(tt.at); !got.Equal(tt.wantClose) {
+				t.Errorf("PreviousClose() = %v, want %v", got, tt.wantClose)
+			}
+		})
+	}
+}
+
+func TestTradingDaysBetween(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b time.Time
+		want int
+	}{
+		{"Same day", ny(2024, time.March, 12, 9, 0), ny(2024, time.March, 12, 17, 0), 0},
+		{"Next day", ny(2024, time.March, 12, 0, 0), ny(2024, time.March, 13, 0, 0), 1},
+		{"Over weekend", ny(2024, time.March, 15, 0, 0), ny(2024, time.March, 18, 0, 0), 1},
+		{"Year 2024", ny(2024, time.January, 1, 0, 0), ny(2025, time.January, 1, 0, 0), 252},
+		{"Reversed", ny(2024, time.March, 18, 0, 0), ny(2024, time.March, 15, 0, 0), -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TradingDaysBetween(tt.a, tt.b); got != tt.want {
+				t.Errorf("TradingDaysBetween() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	c := New()
+	//Closed for the national day of mourning for President Carter
+	if err := c.Reconcile(datetype.Holiday, []*iex.USDate{{Date: "2025-01-09", SettlementDate: "2025-01-10"}}); err != nil {
+		t.Fatalf("Calendar.Reconcile() error = %v", err)
+	}
+	if err := c.Reconcile(datetype.Trade, []*iex.USDate{{Date: "2024-07-04"}}); err != nil {
+		t.Fatalf("Calendar.Reconcile() error = %v", err)
+	}
+	if c.IsTradingDay(ny(2025, time.January, 9, 12, 0)) {
+		t.Errorf("Calendar.IsTradingDay() = true for reconciled holiday")
+	}
+	if name, ok := c.Holiday(ny(2025, time.January, 9, 12, 0)); !ok || name != "Market Closed" {
+		t.Errorf("Calendar.Holiday() = %v, %v", name, ok)
+	}
+	if !c.IsTradingDay(ny(2024, time.July, 4, 12, 0)) {
+		t.Errorf("Calendar.IsTradingDay() = false for reconciled trading day")
+	}
+	if IsTradingDay(ny(2024, time.July, 4, 12, 0)) || !IsTradingDay(ny(2025, time.January, 9, 12, 0)) {
+		t.Errorf("Reconcile modified the default calendar")
+	}
+	if err := c.Reconcile("weekend", nil); err == nil {
+		t.Errorf("Calendar.Reconcile() expected error for unknown date type")
+	}
+	if err := c.Reconcile(datetype.Holiday, []*iex.USDate{{Date: "01/09/2025"}}); err == nil {
+		t.Errorf("Calendar.Reconcile() expected error for invalid date")
+	}
+}
@@ -0,0 +1,92 @@
+package calendar
+
+import "time"
+
+//holiday returns the name of the full-day market holiday observed on the date, if any.
+//Rules follow the current NYSE holiday schedule, which NASDAQ shares.
+func holiday(year int, month time.Month, day int) (string, bool) {
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	switch {
+	//New Year's Day falling on a Saturday is not observed on the Friday before
+	case month == time.January && (day == 1 && date.Weekday() != time.Saturday && date.Weekday() != time.Sunday ||
+		day == 2 && date.Weekday() == time.Monday):
+		return "New Year's Day", true
+	case month == time.January && year >= 1998 && date.Equal(nthWeekday(year, time.January, time.Monday, 3)):
+		return "Martin Luther King, Jr. Day", true
+	case month == time.February && date.Equal(nthWeekday(year, time.February, time.Monday, 3)):
+		return "Washington's Birthday", true
+	case date.Equal(easter(year).AddDate(0, 0, -2)):
+		return "Good Friday", true
+	case month == time.May && date.Equal(lastWeekday(year, time.May, time.Monday)):
+		return "Memorial Day", true
+	case month == time.June && year >= 2022 && date.Equal(observed(year, time.June, 19)):
+		return "Juneteenth National Independence Day", true
+	case month == time.July && date.Equal(observed(year, time.July, 4)):
+		return "Independence Day", true
+	case month == time.September && date.Equal(nthWeekday(year, time.September, time.Monday, 1)):
+		return "Labor Day", true
+	case month == time.November && date.Equal(nthWeekday(year, time.November, time.Thursday, 4)):
+		return "Thanksgiving Day", true
+	case month == time.December && date.Equal(observed(year, time.December, 25)):
+		return "Christmas Day", true
+	}
+	return "", false
+}
+
+//earlyClose reports whether the regular session closes at 1:00 p.m. on the date:
+//the day after Thanksgiving, and July 3 and Christmas Eve when they fall Monday through Thursday.
+func earlyClose(year int, month time.Month, day int) bool {
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	switch {
+	case month == time.November:
+		return date.Equal(nthWeekday(year, time.November, time.Thursday, 4).AddDate(0, 0, 1))
+	case month == time.July && day == 3, month == time.December && day == 24:
+		return date.Weekday() >= time.Monday && date.Weekday() <= time.Thursday
+	}
+	return false
+}
+
+//observed move a fixed-date holiday on a Saturday to Friday and on a Sunday to Monday
+func observed(year int, month time.Month, day int) time.Time {
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	switch date.Weekday() {
+	case time.Saturday:
+		return date.AddDate(0, 0, -1)
+	case time.Sunday:
+		return date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+//nthWeekday the nth weekday of the month, starting at 1
+func nthWeekday(year int, month time.Month, weekday time.Weekday, n int) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+	return first.AddDate(0, 0, offset+(n-1)*7)
+}
+
+//lastWeekday the last weekday of the month
+func lastWeekday(year int, month time.Month, weekday time.Weekday) time.Time {
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+	offset := (int(last.Weekday()) - int(weekday) + 7) % 7
+	return last.AddDate(0, 0, -offset)
+}
+
+//easter Western Easter Sunday, using the anonymous Gregorian algorithm
+func easter(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
@@ -2,38 +2,67 @@
 package iex
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Z-M-Huang/go-iex/enum/chartrange"
 )
 
+//maxBatchSymbols symbols allowed per batch request
+const maxBatchSymbols = 100
+
+//Time series IDs of the economic data endpoints
+const (
+	treasuryID = "treasury"
+	energyID   = "energy"
+	economicID = "economic"
+)
+
 //Client IEX http client
 type Client struct {
-	baseURL string
-	sseURL  string
-	sk      string
-	client  *http.Client
+	baseURL   string
+	sseURL    string
+	sk        string
+	version   string
+	userAgent string
+	timeout   time.Duration
+	retry     RetryPolicy
+	limiter   RateLimiter
+	budget    int64
+	usage     messageCounter
+	client    *http.Client
 }
 
-//NewClient new IEX http client
-func NewClient(sk string, sandbox bool) *Client {
+//NewClient new IEX http client, defaulting to the live cloud environment
+func NewClient(sk string, opts ...Option) *Client {
 	c := &Client{
-		sk:     sk,
-		client: &http.Client{},
+		baseURL:   cloudURL,
+		sseURL:    cloudSSEURL,
+		sk:        sk,
+		version:   defaultVersion,
+		userAgent: defaultUserAgent,
+		client:    &http.Client{},
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
 
-	if sandbox {
-		c.baseURL = "https://sandbox.iexapis.com/stable"
-		c.sseURL = "https://sandbox-sse.iexapis.com/stable"
-	} else {
-		c.baseURL = "https://cloud.iexapis.com/stable"
-		c.sseURL = "https://cloud-sse.iexapis.com/stable"
+	c.baseURL = joinURL(c.baseURL, c.version)
+	c.sseURL = joinURL(c.sseURL, c.version)
+	if c.timeout > 0 {
+		hc := *c.client
+		hc.Timeout = c.timeout
+		c.client = &hc
 	}
 	return c
 }
@@ -54,9 +83,14 @@ func NewClient(sk string, sandbox bool) *Client {
 
 //Metadata https://iexcloud.io/docs/api/#metadata
 func (o *Client) Metadata() (*Metadata, error) {
+	return o.MetadataContext(context.Background())
+}
+
+//MetadataContext Metadata with a context for cancellation and deadlines
+func (o *Client) MetadataContext(ctx context.Context) (*Metadata, error) {
 	params := url.Values{}
 	params.Add("token", o.sk)
-	req, err := http.NewRequest(http.MethodGet, o.getEndpoint("/account/metadata", params.Encode()), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/account/metadata", params.Encode()), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -70,9 +104,14 @@ func (o *Client) Metadata() (*Metadata, error) {
 
 //Book https://iexcloud.io/docs/api/#book
 func (o *Client) Book(symbol string) (*Book, error) {
+	return o.BookContext(context.Background(), symbol)
+}
+
+//BookContext Book with a context for cancellation and deadlines
+func (o *Client) BookContext(ctx context.Context, symbol string) (*Book, error) {
 	params := url.Values{}
 	params.Add("token", o.sk)
-	req, err := http.NewRequest(http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/book", symbol), params.Encode()), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/book", symbol), params.Encode()), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -86,6 +125,11 @@ func (o *Client) Book(symbol string) (*Book, error) {
 
 //HistoricalPrice https://iexcloud.io/docs/api/#historical-prices
 func (o *Client) HistoricalPrice(option HistoricalOption) ([]*HistoricalPrice, error) {
+	return o.HistoricalPriceContext(context.Background(), option)
+}
+
+//HistoricalPriceContext HistoricalPrice with a context for cancellation and deadlines
+func (o *Client) HistoricalPriceContext(ctx context.Context, option HistoricalOption) ([]*HistoricalPrice, error) {
 	option.Range = strings.ToLower(option.Range)
 	params := url.Values{}
 	params.Add("token", o.sk)
@@ -119,7 +163,7 @@ func (o *Client) HistoricalPrice(option HistoricalOption) ([]*HistoricalPrice, e
 	if option.includeToday {
 		params.Add("includeToday", "true")
 	}
-	req, err := http.NewRequest(http.MethodGet, o.getEndpoint(endpoint, params.Encode()), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(endpoint, params.Encode()), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -133,6 +177,11 @@ func (o *Client) HistoricalPrice(option HistoricalOption) ([]*HistoricalPrice, e
 
 //IntradayPrice for https://iexcloud.io/docs/api/#intraday-prices
 func (o *Client) IntradayPrice(option IntradayOption) ([]*IntradayPrice, error) {
+	return o.IntradayPriceContext(context.Background(), option)
+}
+
+//IntradayPriceContext IntradayPrice with a context for cancellation and deadlines
+func (o *Client) IntradayPriceContext(ctx context.Context, option IntradayOption) ([]*IntradayPrice, error) {
 	params := url.Values{}
 	params.Add("token", o.sk)
 	if option.ChartIEXOnly {
@@ -159,7 +208,7 @@ func (o *Client) IntradayPrice(option IntradayOption) ([]*IntradayPrice, error)
 	if option.ChartIEXWhenNull {
 		params.Add("chartIEXWhenNull", "true")
 	}
-	req, err := http.NewRequest(http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/intraday-prices", option.Symbol), params.Encode()), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/intraday-prices", option.Symbol), params.Encode()), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -173,9 +222,14 @@ func (o *Client) IntradayPrice(option IntradayOption) ([]*IntradayPrice, error)
 
 //DelayedQuote https://iexcloud.io/docs/api/#delayed-quote
 func (o *Client) DelayedQuote(symbol string) (*DelayedQuote, error) {
+	return o.DelayedQuoteContext(context.Background(), symbol)
+}
+
+//DelayedQuoteContext DelayedQuote with a context for cancellation and deadlines
+func (o *Client) DelayedQuoteContext(ctx context.Context, symbol string) (*DelayedQuote, error) {
 	params := url.Values{}
 	params.Add("token", o.sk)
-	req, err := http.NewRequest(http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/delayed-quote", symbol), params.Encode()), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/delayed-quote", symbol), params.Encode()), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -189,9 +243,14 @@ func (o *Client) DelayedQuote(symbol string) (*DelayedQuote, error) {
 
 //LargestTrades https://iexcloud.io/docs/api/#largest-trades
 func (o *Client) LargestTrades(symbol string) ([]*LargestTrade, error) {
+	return o.LargestTradesContext(context.Background(), symbol)
+}
+
+//LargestTradesContext LargestTrades with a context for cancellation and deadlines
+func (o *Client) LargestTradesContext(ctx context.Context, symbol string) ([]*LargestTrade, error) {
 	params := url.Values{}
 	params.Add("token", o.sk)
-	req, err := http.NewRequest(http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/largest-trades", symbol), params.Encode()), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/largest-trades", symbol), params.Encode()), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -205,9 +264,14 @@ func (o *Client) LargestTrades(symbol string) ([]*LargestTrade, error) {
 
 //OHLC https://iexcloud.io/docs/api/#open-close-price
 func (o *Client) OHLC(symbol string) (*OHLC, error) {
+	return o.OHLCContext(context.Background(), symbol)
+}
+
+//OHLCContext OHLC with a context for cancellation and deadlines
+func (o *Client) OHLCContext(ctx context.Context, symbol string) (*OHLC, error) {
 	params := url.Values{}
 	params.Add("token", o.sk)
-	req, err := http.NewRequest(http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/ohlc", symbol), params.Encode()), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/ohlc", symbol), params.Encode()), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -221,9 +285,14 @@ func (o *Client) OHLC(symbol string) (*OHLC, error) {
 
 //PreviousDayPrice https://iexcloud.io/docs/api/#previous-day-price
 func (o *Client) PreviousDayPrice(symbol string) (*PreviousDayPrice, error) {
+	return o.PreviousDayPriceContext(context.Background(), symbol)
+}
+
+//PreviousDayPriceContext PreviousDayPrice with a context for cancellation and deadlines
+func (o *Client) PreviousDayPriceContext(ctx context.Context, symbol string) (*PreviousDayPrice, error) {
 	params := url.Values{}
 	params.Add("token", o.sk)
-	req, err := http.NewRequest(http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/previous", symbol), params.Encode()), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/previous", symbol), params.Encode()), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -237,9 +306,14 @@ func (o *Client) PreviousDayPrice(symbol string) (*PreviousDayPrice, error) {
 
 //PriceOnly https://iexcloud.io/docs/api/#price-only
 func (o *Client) PriceOnly(symbol string) (*float64, error) {
+	return o.PriceOnlyContext(context.Background(), symbol)
+}
+
+//PriceOnlyContext PriceOnly with a context for cancellation and deadlines
+func (o *Client) PriceOnlyContext(ctx context.Context, symbol string) (*float64, error) {
 	params := url.Values{}
 	params.Add("token", o.sk)
-	req, err := http.NewRequest(http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/price", symbol), params.Encode()), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/price", symbol), params.Encode()), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -252,12 +326,17 @@ func (o *Client) PriceOnly(symbol string) (*float64, error) {
 
 //Quote https://iexcloud.io/docs/api/#quote
 func (o *Client) Quote(symbol string, displayPercent bool) (*Quote, error) {
+	return o.QuoteContext(context.Background(), symbol, displayPercent)
+}
+
+//QuoteContext Quote with a context for cancellation and deadlines
+func (o *Client) QuoteContext(ctx context.Context, symbol string, displayPercent bool) (*Quote, error) {
 	params := url.Values{}
 	params.Add("token", o.sk)
 	if displayPercent {
 		params.Add("displayPercent", "true")
 	}
-	req, err := http.NewRequest(http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/quote", symbol), params.Encode()), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/quote", symbol), params.Encode()), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -271,9 +350,14 @@ func (o *Client) Quote(symbol string, displayPercent bool) (*Quote, error) {
 
 //VolumeByVenue https://iexcloud.io/docs/api/#volume-by-venue
 func (o *Client) VolumeByVenue(symbol string) ([]*VolumeByVenue, error) {
+	return o.VolumeByVenueContext(context.Background(), symbol)
+}
+
+//VolumeByVenueContext VolumeByVenue with a context for cancellation and deadlines
+func (o *Client) VolumeByVenueContext(ctx context.Context, symbol string) ([]*VolumeByVenue, error) {
 	params := url.Values{}
 	params.Add("token", o.sk)
-	req, err := http.NewRequest(http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/volume-by-venue", symbol), params.Encode()), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/volume-by-venue", symbol), params.Encode()), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -285,58 +369,1867 @@ func (o *Client) VolumeByVenue(symbol string) ([]*VolumeByVenue, error) {
 	return ret, nil
 }
 
-func (o *Client) getJSON(req *http.Request, out interface{}) error {
-	resp, err := o.doRequest(req)
+//Company https://iexcloud.io/docs/api/#company
+func (o *Client) Company(symbol string) (*Company, error) {
+	return o.CompanyContext(context.Background(), symbol)
+}
+
+//CompanyContext Company with a context for cancellation and deadlines
+func (o *Client) CompanyContext(ctx context.Context, symbol string) (*Company, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/company", symbol), params.Encode()), nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer resp.Body.Close()
-	jsonBytes, err := ioutil.ReadAll(resp.Body)
+	ret := &Company{}
+	err = o.getJSON(req, &ret)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	err = json.Unmarshal(jsonBytes, &out)
+	return ret, nil
+}
+
+//Logo https://iexcloud.io/docs/api/#logo
+func (o *Client) Logo(symbol string) (*Logo, error) {
+	return o.LogoContext(context.Background(), symbol)
+}
+
+//LogoContext Logo with a context for cancellation and deadlines
+func (o *Client) LogoContext(ctx context.Context, symbol string) (*Logo, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/logo", symbol), params.Encode()), nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	ret := &Logo{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
 }
 
-func (o *Client) getFloat64(req *http.Request) (*float64, error) {
-	resp, err := o.doRequest(req)
+//Peers https://iexcloud.io/docs/api/#peers
+func (o *Client) Peers(symbol string) ([]string, error) {
+	return o.PeersContext(context.Background(), symbol)
+}
+
+//PeersContext Peers with a context for cancellation and deadlines
+func (o *Client) PeersContext(ctx context.Context, symbol string) ([]string, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/peers", symbol), params.Encode()), nil)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-	floatBytes, err := ioutil.ReadAll(resp.Body)
+	var ret []string
+	err = o.getJSON(req, &ret)
 	if err != nil {
 		return nil, err
 	}
-	ret, err := strconv.ParseFloat(string(floatBytes), 64)
+	return ret, nil
+}
+
+//InsiderRoster https://iexcloud.io/docs/api/#insider-roster
+func (o *Client) InsiderRoster(symbol string) ([]*InsiderRoster, error) {
+	return o.InsiderRosterContext(context.Background(), symbol)
+}
+
+//InsiderRosterContext InsiderRoster with a context for cancellation and deadlines
+func (o *Client) InsiderRosterContext(ctx context.Context, symbol string) ([]*InsiderRoster, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/insider-roster", symbol), params.Encode()), nil)
 	if err != nil {
 		return nil, err
 	}
-	return &ret, nil
+	var ret []*InsiderRoster
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
 }
 
-func (o *Client) doRequest(req *http.Request) (*http.Response, error) {
-	req.Header.Add("Host", "https://github.com/Z-M-Huang/go-iex")
-	resp, err := o.client.Do(req)
+//KeyStats https://iexcloud.io/docs/api/#key-stats
+//When stats are given only those are fetched, one request of one message each, e.g. KeyStats("AAPL", "peRatio", "beta").
+//Stats are named after the json tags of KeyStats, other names return an error without sending a request.
+func (o *Client) KeyStats(symbol string, stats ...string) (*KeyStats, error) {
+	return o.KeyStatsContext(context.Background(), symbol, stats...)
+}
+
+//KeyStatsContext KeyStats with a context for cancellation and deadlines
+func (o *Client) KeyStatsContext(ctx context.Context, symbol string, stats ...string) (*KeyStats, error) {
+	for _, stat := range stats {
+		if !keyStatNames[stat] {
+			return nil, fmt.Errorf("unknown key stat %q", stat)
+		}
+	}
+	params := url.Values{}
+	params.Add("token", o.sk)
+	ret := &KeyStats{}
+	if len(stats) == 0 {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/stats", symbol), params.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+		err = o.getJSON(req, &ret)
+		if err != nil {
+			return nil, err
+		}
+		return ret, nil
+	}
+	for _, stat := range stats {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/stats/%s", symbol, stat), params.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+		body, err := o.getString(req)
+		if err != nil {
+			return nil, err
+		}
+		//A single stat is returned as a bare value, string stats possibly as plain text, put it back under its key
+		value := json.RawMessage(strings.TrimSpace(body))
+		if !json.Valid(value) {
+			value, err = json.Marshal(string(value))
+			if err != nil {
+				return nil, err
+			}
+		}
+		b, err := json.Marshal(map[string]json.RawMessage{stat: value})
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(b, ret)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+//AdvancedStats https://iexcloud.io/docs/api/#advanced-stats
+func (o *Client) AdvancedStats(symbol string) (*AdvancedStats, error) {
+	return o.AdvancedStatsContext(context.Background(), symbol)
+}
+
+//AdvancedStatsContext AdvancedStats with a context for cancellation and deadlines
+func (o *Client) AdvancedStatsContext(ctx context.Context, symbol string) (*AdvancedStats, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/advanced-stats", symbol), params.Encode()), nil)
 	if err != nil {
 		return nil, err
 	}
-	if resp.StatusCode != http.StatusOK {
-		contentBytes, err := ioutil.ReadAll(resp.Body)
-		msg := ""
-		if err == nil {
-			msg = string(contentBytes)
+	ret := &AdvancedStats{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//IncomeStatement https://iexcloud.io/docs/api/#income-statement
+func (o *Client) IncomeStatement(option FinancialOption) ([]*IncomeStatement, error) {
+	return o.IncomeStatementContext(context.Background(), option)
+}
+
+//IncomeStatementContext IncomeStatement with a context for cancellation and deadlines
+func (o *Client) IncomeStatementContext(ctx context.Context, option FinancialOption) ([]*IncomeStatement, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/income", option.Symbol), o.financialParams(option).Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := &incomeStatements{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret.Income, nil
+}
+
+//BalanceSheet https://iexcloud.io/docs/api/#balance-sheet
+func (o *Client) BalanceSheet(option FinancialOption) ([]*BalanceSheet, error) {
+	return o.BalanceSheetContext(context.Background(), option)
+}
+
+//BalanceSheetContext BalanceSheet with a context for cancellation and deadlines
+func (o *Client) BalanceSheetContext(ctx context.Context, option FinancialOption) ([]*BalanceSheet, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/balance-sheet", option.Symbol), o.financialParams(option).Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := &balanceSheets{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret.BalanceSheet, nil
+}
+
+//CashFlow https://iexcloud.io/docs/api/#cash-flow
+func (o *Client) CashFlow(option FinancialOption) ([]*CashFlow, error) {
+	return o.CashFlowContext(context.Background(), option)
+}
+
+//CashFlowContext CashFlow with a context for cancellation and deadlines
+func (o *Client) CashFlowContext(ctx context.Context, option FinancialOption) ([]*CashFlow, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/cash-flow", option.Symbol), o.financialParams(option).Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := &cashFlows{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret.CashFlow, nil
+}
+
+//Financials https://iexcloud.io/docs/api/#financials
+func (o *Client) Financials(option FinancialOption) ([]*Financials, error) {
+	return o.FinancialsContext(context.Background(), option)
+}
+
+//FinancialsContext Financials with a context for cancellation and deadlines
+func (o *Client) FinancialsContext(ctx context.Context, option FinancialOption) ([]*Financials, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/financials", option.Symbol), o.financialParams(option).Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := &financials{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret.Financials, nil
+}
+
+//Fundamentals income statements, balance sheets and cash flows aligned by fiscal period and report date, latest first
+func (o *Client) Fundamentals(option FinancialOption) ([]*Fundamentals, error) {
+	return o.FundamentalsContext(context.Background(), option)
+}
+
+//FundamentalsContext Fundamentals with a context for cancellation and deadlines
+func (o *Client) FundamentalsContext(ctx context.Context, option FinancialOption) ([]*Fundamentals, error) {
+	incomeStatements, err := o.IncomeStatementContext(ctx, option)
+	if err != nil {
+		return nil, err
+	}
+	balanceSheets, err := o.BalanceSheetContext(ctx, option)
+	if err != nil {
+		return nil, err
+	}
+	cashFlows, err := o.CashFlowContext(ctx, option)
+	if err != nil {
+		return nil, err
+	}
+
+	periods := make(map[[2]string]*Fundamentals)
+	var ret []*Fundamentals
+	period := func(p FiscalPeriod) *Fundamentals {
+		key := [2]string{p.FiscalDate, p.ReportDate}
+		f, ok := periods[key]
+		if !ok {
+			f = &Fundamentals{
+				FiscalDate: p.FiscalDate,
+				ReportDate: p.ReportDate,
+			}
+			periods[key] = f
+			ret = append(ret, f)
 		}
-		return nil, APIError{
-			StatusCode: resp.StatusCode,
-			Message:    msg,
+		return f
+	}
+	for _, r := range incomeStatements {
+		period(r.FiscalPeriod).IncomeStatement = r
+	}
+	for _, r := range balanceSheets {
+		period(r.FiscalPeriod).BalanceSheet = r
+	}
+	for _, r := range cashFlows {
+		period(r.FiscalPeriod).CashFlow = r
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].FiscalDate != ret[j].FiscalDate {
+			return ret[i].FiscalDate > ret[j].FiscalDate
+		}
+		return ret[i].ReportDate > ret[j].ReportDate
+	})
+	return ret, nil
+}
+
+//Dividends https://iexcloud.io/docs/api/#dividends-basic
+//actionRange is one of the actionrange options, defaulting to 1m when empty.
+func (o *Client) Dividends(symbol, actionRange string) ([]*Dividend, error) {
+	return o.DividendsContext(context.Background(), symbol, actionRange)
+}
+
+//DividendsContext Dividends with a context for cancellation and deadlines
+func (o *Client) DividendsContext(ctx context.Context, symbol, actionRange string) ([]*Dividend, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	endpoint := fmt.Sprintf("/stock/%s/dividends", symbol)
+	if actionRange != "" {
+		endpoint += fmt.Sprintf("/%s", strings.ToLower(actionRange))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(endpoint, params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*Dividend
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//Splits https://iexcloud.io/docs/api/#splits-basic
+//actionRange is one of the actionrange options, defaulting to 1m when empty.
+func (o *Client) Splits(symbol, actionRange string) ([]*Split, error) {
+	return o.SplitsContext(context.Background(), symbol, actionRange)
+}
+
+//SplitsContext Splits with a context for cancellation and deadlines
+func (o *Client) SplitsContext(ctx context.Context, symbol, actionRange string) ([]*Split, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	endpoint := fmt.Sprintf("/stock/%s/splits", symbol)
+	if actionRange != "" {
+		endpoint += fmt.Sprintf("/%s", strings.ToLower(actionRange))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(endpoint, params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*Split
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//Earnings https://iexcloud.io/docs/api/#earnings
+//last number of quarters to return, defaulting to 1 when 0.
+func (o *Client) Earnings(symbol string, last int) ([]*Earning, error) {
+	return o.EarningsContext(context.Background(), symbol, last)
+}
+
+//EarningsContext Earnings with a context for cancellation and deadlines
+func (o *Client) EarningsContext(ctx context.Context, symbol string, last int) ([]*Earning, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	endpoint := fmt.Sprintf("/stock/%s/earnings", symbol)
+	if last > 0 {
+		endpoint += fmt.Sprintf("/%d", last)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(endpoint, params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := &earnings{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret.Earnings, nil
+}
+
+//UpcomingEarnings https://iexcloud.io/docs/api/#upcoming-events
+//Use "market" as symbol for all symbols.
+func (o *Client) UpcomingEarnings(symbol string) ([]*UpcomingEarning, error) {
+	return o.UpcomingEarningsContext(context.Background(), symbol)
+}
+
+//UpcomingEarningsContext UpcomingEarnings with a context for cancellation and deadlines
+func (o *Client) UpcomingEarningsContext(ctx context.Context, symbol string) ([]*UpcomingEarning, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/upcoming-earnings", symbol), params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*UpcomingEarning
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//UpcomingDividends https://iexcloud.io/docs/api/#upcoming-events
+//Use "market" as symbol for all symbols.
+func (o *Client) UpcomingDividends(symbol string) ([]*Dividend, error) {
+	return o.UpcomingDividendsContext(context.Background(), symbol)
+}
+
+//UpcomingDividendsContext UpcomingDividends with a context for cancellation and deadlines
+func (o *Client) UpcomingDividendsContext(ctx context.Context, symbol string) ([]*Dividend, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/upcoming-dividends", symbol), params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*Dividend
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//UpcomingSplits https://iexcloud.io/docs/api/#upcoming-events
+//Use "market" as symbol for all symbols.
+func (o *Client) UpcomingSplits(symbol string) ([]*Split, error) {
+	return o.UpcomingSplitsContext(context.Background(), symbol)
+}
+
+//UpcomingSplitsContext UpcomingSplits with a context for cancellation and deadlines
+func (o *Client) UpcomingSplitsContext(ctx context.Context, symbol string) ([]*Split, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/upcoming-splits", symbol), params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*Split
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//UpcomingIPOs https://iexcloud.io/docs/api/#ipo-calendar
+func (o *Client) UpcomingIPOs() (*IPOCalendar, error) {
+	return o.UpcomingIPOsContext(context.Background())
+}
+
+//UpcomingIPOsContext UpcomingIPOs with a context for cancellation and deadlines
+func (o *Client) UpcomingIPOsContext(ctx context.Context) (*IPOCalendar, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/stock/market/upcoming-ipos", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := &IPOCalendar{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//Estimates https://iexcloud.io/docs/api/#estimates
+func (o *Client) Estimates(option FinancialOption) ([]*Estimate, error) {
+	return o.EstimatesContext(context.Background(), option)
+}
+
+//EstimatesContext Estimates with a context for cancellation and deadlines
+func (o *Client) EstimatesContext(ctx context.Context, option FinancialOption) ([]*Estimate, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/estimates", option.Symbol), o.financialParams(option).Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := &estimates{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret.Estimates, nil
+}
+
+//PriceTarget https://iexcloud.io/docs/api/#price-target
+func (o *Client) PriceTarget(symbol string) (*PriceTarget, error) {
+	return o.PriceTargetContext(context.Background(), symbol)
+}
+
+//PriceTargetContext PriceTarget with a context for cancellation and deadlines
+func (o *Client) PriceTargetContext(ctx context.Context, symbol string) (*PriceTarget, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/price-target", symbol), params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := &PriceTarget{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//FundOwnership https://iexcloud.io/docs/api/#fund-ownership
+func (o *Client) FundOwnership(symbol string) ([]*FundOwnership, error) {
+	return o.FundOwnershipContext(context.Background(), symbol)
+}
+
+//FundOwnershipContext FundOwnership with a context for cancellation and deadlines
+func (o *Client) FundOwnershipContext(ctx context.Context, symbol string) ([]*FundOwnership, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/fund-ownership", symbol), params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*FundOwnership
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//InstitutionalOwnership https://iexcloud.io/docs/api/#institutional-ownership
+func (o *Client) InstitutionalOwnership(symbol string) ([]*InstitutionalOwnership, error) {
+	return o.InstitutionalOwnershipContext(context.Background(), symbol)
+}
+
+//InstitutionalOwnershipContext InstitutionalOwnership with a context for cancellation and deadlines
+func (o *Client) InstitutionalOwnershipContext(ctx context.Context, symbol string) ([]*InstitutionalOwnership, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/institutional-ownership", symbol), params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*InstitutionalOwnership
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//InsiderTransactions https://iexcloud.io/docs/api/#insider-transactions
+func (o *Client) InsiderTransactions(symbol string) ([]*InsiderTransaction, error) {
+	return o.InsiderTransactionsContext(context.Background(), symbol)
+}
+
+//InsiderTransactionsContext InsiderTransactions with a context for cancellation and deadlines
+func (o *Client) InsiderTransactionsContext(ctx context.Context, symbol string) ([]*InsiderTransaction, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/insider-transactions", symbol), params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*InsiderTransaction
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//InsiderSummary https://iexcloud.io/docs/api/#insider-summary
+func (o *Client) InsiderSummary(symbol string) ([]*InsiderSummary, error) {
+	return o.InsiderSummaryContext(context.Background(), symbol)
+}
+
+//InsiderSummaryContext InsiderSummary with a context for cancellation and deadlines
+func (o *Client) InsiderSummaryContext(ctx context.Context, symbol string) ([]*InsiderSummary, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/insider-summary", symbol), params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*InsiderSummary
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//RecommendationTrends https://iexcloud.io/docs/api/#recommendation-trends
+func (o *Client) RecommendationTrends(symbol string) ([]*RecommendationTrend, error) {
+	return o.RecommendationTrendsContext(context.Background(), symbol)
+}
+
+//RecommendationTrendsContext RecommendationTrends with a context for cancellation and deadlines
+func (o *Client) RecommendationTrendsContext(ctx context.Context, symbol string) ([]*RecommendationTrend, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/recommendation-trends", symbol), params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*RecommendationTrend
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//News https://iexcloud.io/docs/api/#news
+//last number of items to return, between 1 and 50. Defaults to 10 when 0.
+func (o *Client) News(symbol string, last int) ([]*NewsItem, error) {
+	return o.NewsContext(context.Background(), symbol, last)
+}
+
+//NewsContext News with a context for cancellation and deadlines
+func (o *Client) NewsContext(ctx context.Context, symbol string, last int) ([]*NewsItem, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	endpoint := fmt.Sprintf("/stock/%s/news", symbol)
+	if last > 0 {
+		endpoint += fmt.Sprintf("/last/%d", last)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(endpoint, params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*NewsItem
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//MarketNews https://iexcloud.io/docs/api/#news
+//last number of items to return, between 1 and 50. Defaults to 10 when 0.
+func (o *Client) MarketNews(last int) ([]*NewsItem, error) {
+	return o.MarketNewsContext(context.Background(), last)
+}
+
+//MarketNewsContext MarketNews with a context for cancellation and deadlines
+func (o *Client) MarketNewsContext(ctx context.Context, last int) ([]*NewsItem, error) {
+	return o.NewsContext(ctx, "market", last)
+}
+
+//List https://iexcloud.io/docs/api/#list
+//listType is one of the listtype options.
+func (o *Client) List(listType string, option ListOption) ([]*Quote, error) {
+	return o.ListContext(context.Background(), listType, option)
+}
+
+//ListContext List with a context for cancellation and deadlines
+func (o *Client) ListContext(ctx context.Context, listType string, option ListOption) ([]*Quote, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	if option.DisplayPercent {
+		params.Add("displayPercent", "true")
+	}
+	if option.ListLimit > 0 {
+		params.Add("listLimit", strconv.Itoa(option.ListLimit))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/market/list/%s", strings.ToLower(listType)), params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*Quote
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//SectorPerformance https://iexcloud.io/docs/api/#sector-performance
+func (o *Client) SectorPerformance() ([]*SectorPerformance, error) {
+	return o.SectorPerformanceContext(context.Background())
+}
+
+//SectorPerformanceContext SectorPerformance with a context for cancellation and deadlines
+func (o *Client) SectorPerformanceContext(ctx context.Context) ([]*SectorPerformance, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/stock/market/sector-performance", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*SectorPerformance
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//MarketVolume https://iexcloud.io/docs/api/#u-s-exchanges
+func (o *Client) MarketVolume() ([]*MarketVolume, error) {
+	return o.MarketVolumeContext(context.Background())
+}
+
+//MarketVolumeContext MarketVolume with a context for cancellation and deadlines
+func (o *Client) MarketVolumeContext(ctx context.Context) ([]*MarketVolume, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/market", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*MarketVolume
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//Symbols https://iexcloud.io/docs/api/#symbols
+func (o *Client) Symbols() ([]*Symbol, error) {
+	return o.SymbolsContext(context.Background())
+}
+
+//SymbolsContext Symbols with a context for cancellation and deadlines
+func (o *Client) SymbolsContext(ctx context.Context) ([]*Symbol, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/ref-data/symbols", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*Symbol
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//IEXSymbols https://iexcloud.io/docs/api/#iex-symbols
+func (o *Client) IEXSymbols() ([]*IEXSymbol, error) {
+	return o.IEXSymbolsContext(context.Background())
+}
+
+//IEXSymbolsContext IEXSymbols with a context for cancellation and deadlines
+func (o *Client) IEXSymbolsContext(ctx context.Context) ([]*IEXSymbol, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/ref-data/iex/symbols", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*IEXSymbol
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//OTCSymbols https://iexcloud.io/docs/api/#otc-symbols
+func (o *Client) OTCSymbols() ([]*Symbol, error) {
+	return o.OTCSymbolsContext(context.Background())
+}
+
+//OTCSymbolsContext OTCSymbols with a context for cancellation and deadlines
+func (o *Client) OTCSymbolsContext(ctx context.Context) ([]*Symbol, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/ref-data/otc/symbols", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*Symbol
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//MutualFundSymbols https://iexcloud.io/docs/api/#mutual-fund-symbols
+func (o *Client) MutualFundSymbols() ([]*Symbol, error) {
+	return o.MutualFundSymbolsContext(context.Background())
+}
+
+//MutualFundSymbolsContext MutualFundSymbols with a context for cancellation and deadlines
+func (o *Client) MutualFundSymbolsContext(ctx context.Context) ([]*Symbol, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/ref-data/mutual-funds/symbols", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*Symbol
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//Exchanges https://iexcloud.io/docs/api/#international-exchanges
+func (o *Client) Exchanges() ([]*Exchange, error) {
+	return o.ExchangesContext(context.Background())
+}
+
+//ExchangesContext Exchanges with a context for cancellation and deadlines
+func (o *Client) ExchangesContext(ctx context.Context) ([]*Exchange, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/ref-data/exchanges", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*Exchange
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//RegionSymbols https://iexcloud.io/docs/api/#international-symbols
+func (o *Client) RegionSymbols(region string) ([]*Symbol, error) {
+	return o.RegionSymbolsContext(context.Background(), region)
+}
+
+//RegionSymbolsContext RegionSymbols with a context for cancellation and deadlines
+func (o *Client) RegionSymbolsContext(ctx context.Context, region string) ([]*Symbol, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/ref-data/region/%s/symbols", region), params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*Symbol
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//Sectors https://iexcloud.io/docs/api/#sectors
+func (o *Client) Sectors() ([]*Sector, error) {
+	return o.SectorsContext(context.Background())
+}
+
+//SectorsContext Sectors with a context for cancellation and deadlines
+func (o *Client) SectorsContext(ctx context.Context) ([]*Sector, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/ref-data/sectors", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*Sector
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//Tags https://iexcloud.io/docs/api/#tags
+func (o *Client) Tags() ([]*Tag, error) {
+	return o.TagsContext(context.Background())
+}
+
+//TagsContext Tags with a context for cancellation and deadlines
+func (o *Client) TagsContext(ctx context.Context) ([]*Tag, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/ref-data/tags", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*Tag
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//USDates https://iexcloud.io/docs/api/#u-s-holidays-and-trading-dates
+//Type is one of the datetype options and Direction one of the direction options.
+func (o *Client) USDates(option USDateOption) ([]*USDate, error) {
+	return o.USDatesContext(context.Background(), option)
+}
+
+//USDatesContext USDates with a context for cancellation and deadlines
+func (o *Client) USDatesContext(ctx context.Context, option USDateOption) ([]*USDate, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	endpoint := fmt.Sprintf("/ref-data/us/dates/%s/%s", option.Type, option.Direction)
+	if option.Last > 0 || option.StartDate != "" {
+		last := option.Last
+		if last <= 0 {
+			last = 1
+		}
+		endpoint += fmt.Sprintf("/%d", last)
+		if option.StartDate != "" {
+			endpoint += fmt.Sprintf("/%s", option.StartDate)
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(endpoint, params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*USDate
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//CryptoBook https://iexcloud.io/docs/api/#cryptocurrency-book
+func (o *Client) CryptoBook(symbol string) (*CryptoBook, error) {
+	return o.CryptoBookContext(context.Background(), symbol)
+}
+
+//CryptoBookContext CryptoBook with a context for cancellation and deadlines
+func (o *Client) CryptoBookContext(ctx context.Context, symbol string) (*CryptoBook, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/crypto/%s/book", symbol), params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := &CryptoBook{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//CryptoPrice https://iexcloud.io/docs/api/#cryptocurrency-price
+func (o *Client) CryptoPrice(symbol string) (*CryptoPrice, error) {
+	return o.CryptoPriceContext(context.Background(), symbol)
+}
+
+//CryptoPriceContext CryptoPrice with a context for cancellation and deadlines
+func (o *Client) CryptoPriceContext(ctx context.Context, symbol string) (*CryptoPrice, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/crypto/%s/price", symbol), params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := &CryptoPrice{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//CryptoQuote https://iexcloud.io/docs/api/#cryptocurrency-quote
+func (o *Client) CryptoQuote(symbol string) (*CryptoQuote, error) {
+	return o.CryptoQuoteContext(context.Background(), symbol)
+}
+
+//CryptoQuoteContext CryptoQuote with a context for cancellation and deadlines
+func (o *Client) CryptoQuoteContext(ctx context.Context, symbol string) (*CryptoQuote, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/crypto/%s/quote", symbol), params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := &CryptoQuote{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//CryptoSymbols https://iexcloud.io/docs/api/#cryptocurrency-symbols
+func (o *Client) CryptoSymbols() ([]*Symbol, error) {
+	return o.CryptoSymbolsContext(context.Background())
+}
+
+//CryptoSymbolsContext CryptoSymbols with a context for cancellation and deadlines
+func (o *Client) CryptoSymbolsContext(ctx context.Context) ([]*Symbol, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/ref-data/crypto/symbols", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*Symbol
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//FXLatest https://iexcloud.io/docs/api/#latest-currency-rates
+func (o *Client) FXLatest(pairs ...CurrencyPair) ([]*ExchangeRate, error) {
+	return o.FXLatestContext(context.Background(), pairs...)
+}
+
+//FXLatestContext FXLatest with a context for cancellation and deadlines
+func (o *Client) FXLatestContext(ctx context.Context, pairs ...CurrencyPair) ([]*ExchangeRate, error) {
+	symbols, err := pairSymbols(pairs)
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Add("token", o.sk)
+	params.Add("symbols", symbols)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/fx/latest", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*ExchangeRate
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//FXConvert https://iexcloud.io/docs/api/#currency-conversion
+func (o *Client) FXConvert(pairs []CurrencyPair, amount float64) ([]*ConvertedAmount, error) {
+	return o.FXConvertContext(context.Background(), pairs, amount)
+}
+
+//FXConvertContext FXConvert with a context for cancellation and deadlines
+func (o *Client) FXConvertContext(ctx context.Context, pairs []CurrencyPair, amount float64) ([]*ConvertedAmount, error) {
+	symbols, err := pairSymbols(pairs)
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Add("token", o.sk)
+	params.Add("symbols", symbols)
+	params.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/fx/convert", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*ConvertedAmount
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//FXHistorical https://iexcloud.io/docs/api/#historical-daily
+//from and to are dates formatted as YYYY-MM-DD. Rates of all pairs are returned in one slice.
+func (o *Client) FXHistorical(pairs []CurrencyPair, from, to string) ([]*HistoricalRate, error) {
+	return o.FXHistoricalContext(context.Background(), pairs, from, to)
+}
+
+//FXHistoricalContext FXHistorical with a context for cancellation and deadlines
+func (o *Client) FXHistoricalContext(ctx context.Context, pairs []CurrencyPair, from, to string) ([]*HistoricalRate, error) {
+	symbols, err := pairSymbols(pairs)
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Add("token", o.sk)
+	params.Add("symbols", symbols)
+	if from != "" {
+		params.Add("from", from)
+	}
+	if to != "" {
+		params.Add("to", to)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/fx/historical", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	//IEX returns one array of rates per pair
+	var perPair [][]*HistoricalRate
+	err = o.getJSON(req, &perPair)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*HistoricalRate
+	for _, rates := range perPair {
+		ret = append(ret, rates...)
+	}
+	return ret, nil
+}
+
+//FXSymbols https://iexcloud.io/docs/api/#fx-symbols
+func (o *Client) FXSymbols() (*FXSymbols, error) {
+	return o.FXSymbolsContext(context.Background())
+}
+
+//FXSymbolsContext FXSymbols with a context for cancellation and deadlines
+func (o *Client) FXSymbolsContext(ctx context.Context) (*FXSymbols, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/ref-data/fx/symbols", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := &FXSymbols{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//OptionExpirations https://iexcloud.io/docs/api/#end-of-day-options
+func (o *Client) OptionExpirations(symbol string) ([]string, error) {
+	return o.OptionExpirationsContext(context.Background(), symbol)
+}
+
+//OptionExpirationsContext OptionExpirations with a context for cancellation and deadlines
+func (o *Client) OptionExpirationsContext(ctx context.Context, symbol string) ([]string, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/options", symbol), params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []string
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//OptionChain https://iexcloud.io/docs/api/#end-of-day-options
+//expiration is one of OptionExpirations, side is from optionside and empty for both sides.
+func (o *Client) OptionChain(symbol, expiration, side string) ([]*OptionContract, error) {
+	return o.OptionChainContext(context.Background(), symbol, expiration, side)
+}
+
+//OptionChainContext OptionChain with a context for cancellation and deadlines
+func (o *Client) OptionChainContext(ctx context.Context, symbol, expiration, side string) ([]*OptionContract, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	path := fmt.Sprintf("/stock/%s/options/%s", symbol, expiration)
+	if side != "" {
+		path += "/" + side
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(path, params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*OptionContract
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//TimeSeries https://iexcloud.io/docs/api/#time-series
+//The response is decoded into out, a pointer to a slice of the dataset's type or e.g. *[]map[string]interface{}; any other out is an error.
+func (o *Client) TimeSeries(option TimeSeriesOption, out interface{}) error {
+	return o.TimeSeriesContext(context.Background(), option, out)
+}
+
+//TimeSeriesContext TimeSeries with a context for cancellation and deadlines
+func (o *Client) TimeSeriesContext(ctx context.Context, option TimeSeriesOption, out interface{}) error {
+	//Decoding into anything but a pointer would silently discard the response
+	if v := reflect.ValueOf(out); v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf("time series out must be a non-nil pointer, got %T", out)
+	}
+	params := url.Values{}
+	params.Add("token", o.sk)
+	if option.Range != "" {
+		params.Add("range", option.Range)
+	}
+	if option.From != "" {
+		params.Add("from", option.From)
+	}
+	if option.To != "" {
+		params.Add("to", option.To)
+	}
+	if option.On != "" {
+		params.Add("on", option.On)
+	}
+	if option.Last > 0 {
+		params.Add("last", strconv.Itoa(option.Last))
+	}
+	if option.First > 0 {
+		params.Add("first", strconv.Itoa(option.First))
+	}
+	if option.Calendar {
+		params.Add("calendar", "true")
+	}
+	if option.Limit > 0 {
+		params.Add("limit", strconv.Itoa(option.Limit))
+	}
+	if option.Subattribute != "" {
+		params.Add("subattribute", option.Subattribute)
+	}
+	if option.DateField != "" {
+		params.Add("dateField", option.DateField)
+	}
+	path := fmt.Sprintf("/time-series/%s", option.ID)
+	if option.Key != "" {
+		path += "/" + option.Key
+		if option.SubKey != "" {
+			path += "/" + option.SubKey
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(path, params.Encode()), nil)
+	if err != nil {
+		return err
+	}
+	return o.getJSON(req, out)
+}
+
+//DataPoints https://iexcloud.io/docs/api/#data-points
+func (o *Client) DataPoints(symbol string) ([]*DataPoint, error) {
+	return o.DataPointsContext(context.Background(), symbol)
+}
+
+//DataPointsContext DataPoints with a context for cancellation and deadlines
+func (o *Client) DataPointsContext(ctx context.Context, symbol string) ([]*DataPoint, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/data-points/%s", symbol), params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*DataPoint
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//DataPoint https://iexcloud.io/docs/api/#data-points
+//IEX returns the value as plain text, it is not parsed.
+func (o *Client) DataPoint(symbol, key string) (string, error) {
+	return o.DataPointContext(context.Background(), symbol, key)
+}
+
+//DataPointContext DataPoint with a context for cancellation and deadlines
+func (o *Client) DataPointContext(ctx context.Context, symbol, key string) (string, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/data-points/%s/%s", symbol, key), params.Encode()), nil)
+	if err != nil {
+		return "", err
+	}
+	return o.getString(req)
+}
+
+//TreasuryRate https://iexcloud.io/docs/api/#treasury-rates
+//tenor is from treasury. The latest value is fetched from the time series, which includes its date.
+func (o *Client) TreasuryRate(tenor string) (*EconomicValue, error) {
+	return o.TreasuryRateContext(context.Background(), tenor)
+}
+
+//TreasuryRateContext TreasuryRate with a context for cancellation and deadlines
+func (o *Client) TreasuryRateContext(ctx context.Context, tenor string) (*EconomicValue, error) {
+	return o.latestEconomicValue(ctx, treasuryID, tenor)
+}
+
+//TreasuryRateHistory https://iexcloud.io/docs/api/#treasury-rates
+//tenor is from treasury. ID, Key and SubKey of option are ignored.
+func (o *Client) TreasuryRateHistory(tenor string, option TimeSeriesOption) ([]*EconomicValue, error) {
+	return o.TreasuryRateHistoryContext(context.Background(), tenor, option)
+}
+
+//TreasuryRateHistoryContext TreasuryRateHistory with a context for cancellation and deadlines
+func (o *Client) TreasuryRateHistoryContext(ctx context.Context, tenor string, option TimeSeriesOption) ([]*EconomicValue, error) {
+	return o.economicValues(ctx, treasuryID, tenor, option)
+}
+
+//Commodity https://iexcloud.io/docs/api/#commodities
+//kind is from commodity. The latest value is fetched from the time series, which includes its date.
+func (o *Client) Commodity(kind string) (*EconomicValue, error) {
+	return o.CommodityContext(context.Background(), kind)
+}
+
+//CommodityContext Commodity with a context for cancellation and deadlines
+func (o *Client) CommodityContext(ctx context.Context, kind string) (*EconomicValue, error) {
+	return o.latestEconomicValue(ctx, energyID, kind)
+}
+
+//CommodityHistory https://iexcloud.io/docs/api/#commodities
+//kind is from commodity. ID, Key and SubKey of option are ignored.
+func (o *Client) CommodityHistory(kind string, option TimeSeriesOption) ([]*EconomicValue, error) {
+	return o.CommodityHistoryContext(context.Background(), kind, option)
+}
+
+//CommodityHistoryContext CommodityHistory with a context for cancellation and deadlines
+func (o *Client) CommodityHistoryContext(ctx context.Context, kind string, option TimeSeriesOption) ([]*EconomicValue, error) {
+	return o.economicValues(ctx, energyID, kind, option)
+}
+
+//EconomicIndicator https://iexcloud.io/docs/api/#economic-data
+//indicator is from economic. The latest value is fetched from the time series, which includes its date.
+func (o *Client) EconomicIndicator(indicator string) (*EconomicValue, error) {
+	return o.EconomicIndicatorContext(context.Background(), indicator)
+}
+
+//EconomicIndicatorContext EconomicIndicator with a context for cancellation and deadlines
+func (o *Client) EconomicIndicatorContext(ctx context.Context, indicator string) (*EconomicValue, error) {
+	return o.latestEconomicValue(ctx, economicID, indicator)
+}
+
+//EconomicIndicatorHistory https://iexcloud.io/docs/api/#economic-data
+//indicator is from economic. ID, Key and SubKey of option are ignored.
+func (o *Client) EconomicIndicatorHistory(indicator string, option TimeSeriesOption) ([]*EconomicValue, error) {
+	return o.EconomicIndicatorHistoryContext(context.Background(), indicator, option)
+}
+
+//EconomicIndicatorHistoryContext EconomicIndicatorHistory with a context for cancellation and deadlines
+func (o *Client) EconomicIndicatorHistoryContext(ctx context.Context, indicator string, option TimeSeriesOption) ([]*EconomicValue, error) {
+	return o.economicValues(ctx, economicID, indicator, option)
+}
+
+func (o *Client) economicValues(ctx context.Context, id, key string, option TimeSeriesOption) ([]*EconomicValue, error) {
+	option.ID = id
+	option.Key = key
+	option.SubKey = ""
+	var ret []*EconomicValue
+	err := o.TimeSeriesContext(ctx, option, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (o *Client) latestEconomicValue(ctx context.Context, id, key string) (*EconomicValue, error) {
+	values, err := o.economicValues(ctx, id, key, TimeSeriesOption{Last: 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no data for %s %s", id, key)
+	}
+	return values[0], nil
+}
+
+//TOPS https://iexcloud.io/docs/api/#tops
+func (o *Client) TOPS(symbols ...string) ([]*TOPS, error) {
+	return o.TOPSContext(context.Background(), symbols...)
+}
+
+//TOPSContext TOPS with a context for cancellation and deadlines
+func (o *Client) TOPSContext(ctx context.Context, symbols ...string) ([]*TOPS, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	params.Add("symbols", strings.Join(symbols, ","))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/tops", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*TOPS
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//TOPSLast https://iexcloud.io/docs/api/#last
+func (o *Client) TOPSLast(symbols ...string) ([]*Last, error) {
+	return o.TOPSLastContext(context.Background(), symbols...)
+}
+
+//TOPSLastContext TOPSLast with a context for cancellation and deadlines
+func (o *Client) TOPSLastContext(ctx context.Context, symbols ...string) ([]*Last, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	params.Add("symbols", strings.Join(symbols, ","))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/tops/last", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*Last
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//Deep https://iexcloud.io/docs/api/#deep
+//Results are keyed by symbol.
+func (o *Client) Deep(symbols ...string) (map[string]*DEEP, error) {
+	return o.DeepContext(context.Background(), symbols...)
+}
+
+//DeepContext Deep with a context for cancellation and deadlines
+func (o *Client) DeepContext(ctx context.Context, symbols ...string) (map[string]*DEEP, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	params.Add("symbols", strings.Join(symbols, ","))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/deep", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var raw json.RawMessage
+	err = o.getJSON(req, &raw)
+	if err != nil {
+		return nil, err
+	}
+	return decodeDeep(raw)
+}
+
+//DeepBook https://iexcloud.io/docs/api/#deep-book
+//Results are keyed by symbol.
+func (o *Client) DeepBook(symbols ...string) (map[string]*DeepBook, error) {
+	return o.DeepBookContext(context.Background(), symbols...)
+}
+
+//DeepBookContext DeepBook with a context for cancellation and deadlines
+func (o *Client) DeepBookContext(ctx context.Context, symbols ...string) (map[string]*DeepBook, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	params.Add("symbols", strings.Join(symbols, ","))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/deep/book", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]*DeepBook{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//DeepTrades https://iexcloud.io/docs/api/#deep-trades
+//Results are keyed by symbol.
+func (o *Client) DeepTrades(symbols ...string) (map[string][]Trade, error) {
+	return o.DeepTradesContext(context.Background(), symbols...)
+}
+
+//DeepTradesContext DeepTrades with a context for cancellation and deadlines
+func (o *Client) DeepTradesContext(ctx context.Context, symbols ...string) (map[string][]Trade, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	params.Add("symbols", strings.Join(symbols, ","))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/deep/trades", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string][]Trade{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//DeepSystemEvent https://iexcloud.io/docs/api/#deep-system-event
+func (o *Client) DeepSystemEvent() (*SystemEvent, error) {
+	return o.DeepSystemEventContext(context.Background())
+}
+
+//DeepSystemEventContext DeepSystemEvent with a context for cancellation and deadlines
+func (o *Client) DeepSystemEventContext(ctx context.Context) (*SystemEvent, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/deep/system-event", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := &SystemEvent{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//DeepTradingStatus https://iexcloud.io/docs/api/#deep-trading-status
+//Results are keyed by symbol.
+func (o *Client) DeepTradingStatus(symbols ...string) (map[string]*TradingStatus, error) {
+	return o.DeepTradingStatusContext(context.Background(), symbols...)
+}
+
+//DeepTradingStatusContext DeepTradingStatus with a context for cancellation and deadlines
+func (o *Client) DeepTradingStatusContext(ctx context.Context, symbols ...string) (map[string]*TradingStatus, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	params.Add("symbols", strings.Join(symbols, ","))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/deep/trading-status", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]*TradingStatus{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//DeepOpHaltStatus https://iexcloud.io/docs/api/#deep-operational-halt-status
+//Results are keyed by symbol.
+func (o *Client) DeepOpHaltStatus(symbols ...string) (map[string]*OpHaltStatus, error) {
+	return o.DeepOpHaltStatusContext(context.Background(), symbols...)
+}
+
+//DeepOpHaltStatusContext DeepOpHaltStatus with a context for cancellation and deadlines
+func (o *Client) DeepOpHaltStatusContext(ctx context.Context, symbols ...string) (map[string]*OpHaltStatus, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	params.Add("symbols", strings.Join(symbols, ","))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/deep/op-halt-status", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]*OpHaltStatus{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//DeepSSRStatus https://iexcloud.io/docs/api/#deep-short-sale-price-test-status
+//Results are keyed by symbol.
+func (o *Client) DeepSSRStatus(symbols ...string) (map[string]*SSRStatus, error) {
+	return o.DeepSSRStatusContext(context.Background(), symbols...)
+}
+
+//DeepSSRStatusContext DeepSSRStatus with a context for cancellation and deadlines
+func (o *Client) DeepSSRStatusContext(ctx context.Context, symbols ...string) (map[string]*SSRStatus, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	params.Add("symbols", strings.Join(symbols, ","))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/deep/ssr-status", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]*SSRStatus{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//DeepSecurityEvent https://iexcloud.io/docs/api/#deep-security-event
+//Results are keyed by symbol.
+func (o *Client) DeepSecurityEvent(symbols ...string) (map[string]*SecurityEvent, error) {
+	return o.DeepSecurityEventContext(context.Background(), symbols...)
+}
+
+//DeepSecurityEventContext DeepSecurityEvent with a context for cancellation and deadlines
+func (o *Client) DeepSecurityEventContext(ctx context.Context, symbols ...string) (map[string]*SecurityEvent, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	params.Add("symbols", strings.Join(symbols, ","))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/deep/security-event", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]*SecurityEvent{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//DeepTradeBreaks https://iexcloud.io/docs/api/#deep-trade-break
+//Results are keyed by symbol.
+func (o *Client) DeepTradeBreaks(symbols ...string) (map[string][]Trade, error) {
+	return o.DeepTradeBreaksContext(context.Background(), symbols...)
+}
+
+//DeepTradeBreaksContext DeepTradeBreaks with a context for cancellation and deadlines
+func (o *Client) DeepTradeBreaksContext(ctx context.Context, symbols ...string) (map[string][]Trade, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	params.Add("symbols", strings.Join(symbols, ","))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/deep/trade-breaks", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string][]Trade{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//DeepAuction https://iexcloud.io/docs/api/#deep-auction
+//Results are keyed by symbol.
+func (o *Client) DeepAuction(symbols ...string) (map[string]*Auction, error) {
+	return o.DeepAuctionContext(context.Background(), symbols...)
+}
+
+//DeepAuctionContext DeepAuction with a context for cancellation and deadlines
+func (o *Client) DeepAuctionContext(ctx context.Context, symbols ...string) (map[string]*Auction, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	params.Add("symbols", strings.Join(symbols, ","))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/deep/auction", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]*Auction{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//DeepOfficialPrice https://iexcloud.io/docs/api/#deep-official-price
+//Results are keyed by symbol.
+func (o *Client) DeepOfficialPrice(symbols ...string) (map[string]*OfficialPrice, error) {
+	return o.DeepOfficialPriceContext(context.Background(), symbols...)
+}
+
+//DeepOfficialPriceContext DeepOfficialPrice with a context for cancellation and deadlines
+func (o *Client) DeepOfficialPriceContext(ctx context.Context, symbols ...string) (map[string]*OfficialPrice, error) {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	params.Add("symbols", strings.Join(symbols, ","))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/deep/official-price", params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]*OfficialPrice{}
+	err = o.getJSON(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//Batch https://iexcloud.io/docs/api/#batch-requests
+//Symbols are requested in chunks of 100. The result is keyed by uppercased symbol, at least one symbol is required.
+func (o *Client) Batch(option BatchOption) (map[string]*BatchResult, error) {
+	return o.BatchContext(context.Background(), option)
+}
+
+//BatchContext Batch with a context for cancellation and deadlines
+func (o *Client) BatchContext(ctx context.Context, option BatchOption) (map[string]*BatchResult, error) {
+	if len(option.Symbols) == 0 {
+		return nil, errors.New("batch requires at least one symbol")
+	}
+	params := url.Values{}
+	params.Add("token", o.sk)
+	params.Add("types", strings.Join(option.Types, ","))
+	if option.DisplayPercent {
+		params.Add("displayPercent", "true")
+	}
+	if option.Chart != nil {
+		chart := option.Chart
+		if chart.Range != "" {
+			params.Set("range", strings.ToLower(chart.Range))
+			if strings.ToLower(chart.Range) == chartrange.Date && chart.ExactDate != "" {
+				params.Set("exactDate", chart.ExactDate)
+				params.Set("chartByDate", "true")
+			}
+		}
+		if chart.ChartCloseOnly {
+			params.Set("chartCloseOnly", "true")
+		}
+		if chart.ChartSimplify {
+			params.Set("chartSimplify", "true")
+		}
+		if chart.ChartInterval > 0 {
+			params.Set("chartInterval", strconv.Itoa(chart.ChartInterval))
+		}
+		if chart.ChangeFromClose {
+			params.Set("changeFromClose", "true")
+		}
+		if chart.ChartLast > 0 {
+			params.Set("chartLast", strconv.Itoa(chart.ChartLast))
+		}
+		if chart.Sort != "" {
+			params.Set("sort", chart.Sort)
+		}
+	}
+	if option.Intraday != nil {
+		intraday := option.Intraday
+		if intraday.ChartIEXOnly {
+			params.Set("chartIEXOnly", "true")
+		}
+		if intraday.ChartReset {
+			params.Set("chartReset", "true")
+		}
+		if intraday.ChartSimplify {
+			params.Set("chartSimplify", "true")
+		}
+		if intraday.ChartInterval > 0 {
+			params.Set("chartInterval", strconv.Itoa(intraday.ChartInterval))
+		}
+		if intraday.ChangeFromClose {
+			params.Set("changeFromClose", "true")
+		}
+		if intraday.ChartLast > 0 {
+			params.Set("chartLast", strconv.Itoa(intraday.ChartLast))
+		}
+		if intraday.ExactDate != "" {
+			params.Set("exactDate", intraday.ExactDate)
+		}
+		if intraday.ChartIEXWhenNull {
+			params.Set("chartIEXWhenNull", "true")
+		}
+	}
+
+	ret := make(map[string]*BatchResult, len(option.Symbols))
+	if len(option.Symbols) == 1 {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint(fmt.Sprintf("/stock/%s/batch", option.Symbols[0]), params.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+		result := &BatchResult{}
+		err = o.getJSON(req, &result)
+		if err != nil {
+			return nil, err
+		}
+		ret[strings.ToUpper(option.Symbols[0])] = result
+		return ret, nil
+	}
+	for start := 0; start < len(option.Symbols); start += maxBatchSymbols {
+		end := start + maxBatchSymbols
+		if end > len(option.Symbols) {
+			end = len(option.Symbols)
+		}
+		params.Set("symbols", strings.Join(option.Symbols[start:end], ","))
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.getEndpoint("/stock/market/batch", params.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+		var chunk map[string]*BatchResult
+		err = o.getJSON(req, &chunk)
+		if err != nil {
+			return nil, err
+		}
+		for symbol, result := range chunk {
+			ret[strings.ToUpper(symbol)] = result
+		}
+	}
+	return ret, nil
+}
+
+func (o *Client) financialParams(option FinancialOption) url.Values {
+	params := url.Values{}
+	params.Add("token", o.sk)
+	if option.Period != "" {
+		params.Add("period", option.Period)
+	}
+	if option.Last > 0 {
+		params.Add("last", strconv.Itoa(option.Last))
+	}
+	return params
+}
+
+func (o *Client) getJSON(req *http.Request, out interface{}) error {
+	resp, err := o.doRequest(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	jsonBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	err = json.Unmarshal(jsonBytes, &out)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *Client) getFloat64(req *http.Request) (*float64, error) {
+	resp, err := o.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	floatBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := strconv.ParseFloat(string(floatBytes), 64)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+func (o *Client) getString(req *http.Request) (string, error) {
+	resp, err := o.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (o *Client) doRequest(req *http.Request) (*http.Response, error) {
+	endpoint := o.endpointKey(req.URL.Path)
+	reserved, err := o.usage.reserve(endpoint, requestWeight(endpoint, req), o.budget)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := o.sendRequest(req)
+	used := int64(0)
+	if err == nil {
+		used = messagesUsed(resp, reserved)
+	}
+	o.usage.settle(endpoint, reserved, used)
+	return resp, err
+}
+
+func (o *Client) sendRequest(req *http.Request) (*http.Response, error) {
+	if o.userAgent != "" {
+		req.Header.Set("User-Agent", o.userAgent)
+	}
+	maxAttempts := o.retry.maxAttempts(req)
+	for attempt := 1; ; attempt++ {
+		if o.limiter != nil {
+			if err := o.limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+		resp, err := o.client.Do(req)
+		if err != nil {
+			if attempt >= maxAttempts || req.Context().Err() != nil {
+				return nil, RequestError{
+					Err:      err,
+					Attempts: attempt,
+				}
+			}
+			if err := sleepContext(req.Context(), o.retry.backoff(attempt, nil)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if resp.StatusCode == http.StatusOK {
+			return resp, nil
+		}
+
+		contentBytes, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		msg := ""
+		if err == nil {
+			msg = string(contentBytes)
+		}
+		apiErr := APIError{
+			StatusCode: resp.StatusCode,
+			Message:    msg,
+			Attempts:   attempt,
+		}
+		if attempt >= maxAttempts || !o.retry.retryableStatus(resp.StatusCode) {
+			return nil, apiErr
+		}
+		if err := sleepContext(req.Context(), o.retry.backoff(attempt, resp)); err != nil {
+			return nil, err
 		}
 	}
-	return resp, nil
 }
 
 func (o *Client) getEndpoint(path, queryString string) string {
@@ -3,45 +3,149 @@ package iex
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io/ioutil"
+	"math"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/Z-M-Huang/go-iex/enum/actionrange"
+	"github.com/Z-M-Huang/go-iex/enum/batchtype"
 	"github.com/Z-M-Huang/go-iex/enum/chartrange"
+	"github.com/Z-M-Huang/go-iex/enum/commodity"
+	"github.com/Z-M-Huang/go-iex/enum/datetype"
+	"github.com/Z-M-Huang/go-iex/enum/direction"
+	"github.com/Z-M-Huang/go-iex/enum/economic"
+	"github.com/Z-M-Huang/go-iex/enum/listtype"
+	"github.com/Z-M-Huang/go-iex/enum/optionside"
+	"github.com/Z-M-Huang/go-iex/enum/period"
+	"github.com/Z-M-Huang/go-iex/enum/systemevent"
+	"github.com/Z-M-Huang/go-iex/enum/treasury"
 )
 
 func TestNewClient(t *testing.T) {
+	hc := &http.Client{}
 	type args struct {
-		sk      string
-		sandbox bool
+		sk   string
+		opts []Option
 	}
 	tests := []struct {
-		name string
-		args args
+		name        string
+		args        args
+		wantBaseURL string
+		wantSSEURL  string
+		wantTimeout time.Duration
 	}{
 		{
 			name: "Sandbox",
 			args: args{
-				sk:      "",
-				sandbox: true,
+				sk:   "",
+				opts: []Option{WithSandbox()},
 			},
+			wantBaseURL: "https://sandbox.iexapis.com/stable",
+			wantSSEURL:  "https://sandbox-sse.iexapis.com/stable",
 		},
 		{
 			name: "Live",
 			args: args{
-				sk:      "",
-				sandbox: false,
+				sk: "",
 			},
+			wantBaseURL: "https://cloud.iexapis.com/stable",
+			wantSSEURL:  "https://cloud-sse.iexapis.com/stable",
+		},
+		{
+			name: "Custom",
+			args: args{
+				sk: "",
+				opts: []Option{
+					WithHTTPClient(hc),
+					WithBaseURL("http://localhost:8080/"),
+					WithSSEURL("http://localhost:8081"),
+					WithVersion("v1"),
+					WithTimeout(time.Second),
+				},
+			},
+			wantBaseURL: "http://localhost:8080/v1",
+			wantSSEURL:  "http://localhost:8081/v1",
+			wantTimeout: time.Second,
+		},
+		{
+			name: "No version",
+			args: args{
+				sk:   "",
+				opts: []Option{WithBaseURL("http://localhost:8080"), WithVersion("")},
+			},
+			wantBaseURL: "http://localhost:8080",
+			wantSSEURL:  "https://cloud-sse.iexapis.com",
+		},
+		{
+			name: "Nil http client",
+			args: args{
+				sk:   "",
+				opts: []Option{WithHTTPClient(nil)},
+			},
+			wantBaseURL: "https://cloud.iexapis.com/stable",
+			wantSSEURL:  "https://cloud-sse.iexapis.com/stable",
+		},
+		{
+			name: "Nil http client with timeout",
+			args: args{
+				sk:   "",
+				opts: []Option{WithHTTPClient(nil), WithTimeout(time.Second)},
+			},
+			wantBaseURL: "https://cloud.iexapis.com/stable",
+			wantSSEURL:  "https://cloud-sse.iexapis.com/stable",
+			wantTimeout: time.Second,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewClient(tt.args.sk, tt.args.sandbox); got == nil {
-				t.Errorf("NewClient() is nil for %s", tt.name)
+			got := NewClient(tt.args.sk, tt.args.opts...)
+			if got == nil {
+				t.Fatalf("NewClient() is nil for %s", tt.name)
+			}
+			if got.baseURL != tt.wantBaseURL {
+				t.Errorf("NewClient() baseURL = %v, want %v", got.baseURL, tt.wantBaseURL)
+			}
+			if got.sseURL != tt.wantSSEURL {
+				t.Errorf("NewClient() sseURL = %v, want %v", got.sseURL, tt.wantSSEURL)
+			}
+			if got.client.Timeout != tt.wantTimeout {
+				t.Errorf("NewClient() timeout = %v, want %v", got.client.Timeout, tt.wantTimeout)
 			}
 		})
 	}
+	if hc.Timeout != 0 {
+		t.Errorf("NewClient() modified the http.Client passed to WithHTTPClient")
+	}
+}
+
+func TestClient_doRequest(t *testing.T) {
+	var gotUserAgent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUserAgent = r.UserAgent()
+		w.Write([]byte("1.5"))
+	}))
+	defer srv.Close()
+
+	o := NewClient("", WithBaseURL(srv.URL), WithUserAgent("test-agent"))
+	got, err := o.PriceOnly("AAPL")
+	if err != nil {
+		t.Fatalf("Client.PriceOnly() error = %v", err)
+	}
+	if *got != 1.5 {
+		t.Errorf("Client.PriceOnly() = %v, want %v", *got, 1.5)
+	}
+	if gotUserAgent != "test-agent" {
+		t.Errorf("User-Agent = %v, want %v", gotUserAgent, "test-agent")
+	}
 }
 
 func TestClient_getJSON(t *testing.T) {
@@ -60,7 +164,7 @@ func TestClient_getJSON(t *testing.T) {
 	}{
 		{
 			name: "Failed to unmarshal",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				req: req1,
 				out: nil,
@@ -101,7 +205,7 @@ func TestClient_getFloat64(t *testing.T) {
 	}{
 		{
 			name: "Failed to parse",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				req: req1,
 			},
@@ -143,7 +247,7 @@ func TestClient_getFloat64(t *testing.T) {
 // 	}{
 // 		{
 // 			name: "Success",
-// 			o:    NewClient("", true),
+// 			o:    NewClient("", WithSandbox()),
 // 			args: args{
 // 				total: 1000000000,
 // 			},
@@ -171,7 +275,7 @@ func TestClient_Metadata(t *testing.T) {
 	}{
 		{
 			name:      "Success",
-			o:         NewClient("", true),
+			o:         NewClient("", WithSandbox()),
 			want:      d,
 			roundTrip: getRoundTripFunc("/account/metadata", http.StatusOK, *d),
 			wantErr:   false,
@@ -187,7 +291,7 @@ func TestClient_Metadata(t *testing.T) {
 		},
 		{
 			name:      "Request Failed",
-			o:         NewClient("", true),
+			o:         NewClient("", WithSandbox()),
 			want:      nil,
 			roundTrip: getRoundTripFunc("/account/metadata", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
 			wantErr:   true,
@@ -226,7 +330,7 @@ func TestClient_Book(t *testing.T) {
 	}{
 		{
 			name: "Success",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				symbol: "AAPL",
 			},
@@ -248,7 +352,7 @@ func TestClient_Book(t *testing.T) {
 		},
 		{
 			name: "Request Failed",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				symbol: "AAPL",
 			},
@@ -290,7 +394,7 @@ func TestClient_HistoricalPrice(t *testing.T) {
 	}{
 		{
 			name: "Case 1",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				option: HistoricalOption{
 					Symbol:          "AAPL",
@@ -309,7 +413,7 @@ func TestClient_HistoricalPrice(t *testing.T) {
 		},
 		{
 			name: "By Date",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				option: HistoricalOption{
 					Symbol:    "AAPL",
@@ -339,7 +443,7 @@ func TestClient_HistoricalPrice(t *testing.T) {
 		},
 		{
 			name: "Request Failed",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				option: HistoricalOption{
 					Symbol:    "AAPL",
@@ -385,7 +489,7 @@ func TestClient_IntradayPrice(t *testing.T) {
 	}{
 		{
 			name: "Case 1",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				option: IntradayOption{
 					Symbol:           "AAPL",
@@ -420,7 +524,7 @@ func TestClient_IntradayPrice(t *testing.T) {
 		},
 		{
 			name: "Request Failed",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				option: IntradayOption{
 					Symbol:    "AAPL",
@@ -465,7 +569,7 @@ func TestClient_DelayedQuote(t *testing.T) {
 	}{
 		{
 			name: "Success",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				symbol: "AAPL",
 			},
@@ -487,7 +591,7 @@ func TestClient_DelayedQuote(t *testing.T) {
 		},
 		{
 			name: "Request Failed",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				symbol: "AAPL",
 			},
@@ -529,7 +633,7 @@ func TestClient_LargestTrades(t *testing.T) {
 	}{
 		{
 			name: "Success",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				symbol: "AAPL",
 			},
@@ -551,7 +655,7 @@ func TestClient_LargestTrades(t *testing.T) {
 		},
 		{
 			name: "Request Failed",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				symbol: "AAPL",
 			},
@@ -593,7 +697,7 @@ func TestClient_OHLC(t *testing.T) {
 	}{
 		{
 			name: "Success",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				symbol: "AAPL",
 			},
@@ -615,7 +719,7 @@ func TestClient_OHLC(t *testing.T) {
 		},
 		{
 			name: "Request Failed",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				symbol: "AAPL",
 			},
@@ -657,7 +761,7 @@ func TestClient_PreviousDayPrice(t *testing.T) {
 	}{
 		{
 			name: "Success",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				symbol: "AAPL",
 			},
@@ -679,7 +783,7 @@ func TestClient_PreviousDayPrice(t *testing.T) {
 		},
 		{
 			name: "Request Failed",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				symbol: "AAPL",
 			},
@@ -720,7 +824,7 @@ func TestClient_PriceOnly(t *testing.T) {
 	}{
 		{
 			name: "Success",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				symbol: "AAPL",
 			},
@@ -742,7 +846,7 @@ func TestClient_PriceOnly(t *testing.T) {
 		},
 		{
 			name: "Request Failed",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				symbol: "AAPL",
 			},
@@ -785,7 +889,7 @@ func TestClient_Quote(t *testing.T) {
 	}{
 		{
 			name: "Success",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				symbol:         "AAPL",
 				displayPercent: true,
@@ -808,7 +912,7 @@ func TestClient_Quote(t *testing.T) {
 		},
 		{
 			name: "Request Failed",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				symbol:         "AAPL",
 				displayPercent: false,
@@ -851,7 +955,7 @@ func TestClient_VolumeByVenue(t *testing.T) {
 	}{
 		{
 			name: "Success",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				symbol: "AAPL",
 			},
@@ -873,7 +977,7 @@ func TestClient_VolumeByVenue(t *testing.T) {
 		},
 		{
 			name: "Request Failed",
-			o:    NewClient("", true),
+			o:    NewClient("", WithSandbox()),
 			args: args{
 				symbol: "AAPL",
 			},
@@ -898,3 +1002,4787 @@ func TestClient_VolumeByVenue(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_QuoteContext(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+	o := &Client{
+		baseURL: srv.URL,
+		client:  &http.Client{},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := o.QuoteContext(ctx, "AAPL", false); !errors.Is(err, context.Canceled) {
+		t.Errorf("Client.QuoteContext() error = %v, want %v", err, context.Canceled)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := o.QuoteContext(ctx, "AAPL", false); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Client.QuoteContext() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestClient_doRequestRetry(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts: 3,
+		BaseBackoff: time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+		Jitter:      0.5,
+	}
+	tests := []struct {
+		name         string
+		method       string
+		policy       RetryPolicy
+		statusCodes  []int
+		wantAttempts int
+		wantErr      bool
+	}{
+		{
+			name:         "Recovers from transient errors",
+			method:       http.MethodGet,
+			policy:       policy,
+			statusCodes:  []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			wantAttempts: 3,
+			wantErr:      false,
+		},
+		{
+			name:         "Gives up after max attempts",
+			method:       http.MethodGet,
+			policy:       policy,
+			statusCodes:  []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusOK},
+			wantAttempts: 3,
+			wantErr:      true,
+		},
+		{
+			name:         "Does not retry client errors",
+			method:       http.MethodGet,
+			policy:       policy,
+			statusCodes:  []int{http.StatusBadRequest, http.StatusOK},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "Does not retry non idempotent requests",
+			method:       http.MethodPost,
+			policy:       policy,
+			statusCodes:  []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "Retries disabled",
+			method:       http.MethodGet,
+			policy:       RetryPolicy{},
+			statusCodes:  []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			o := NewClient("", WithRetryPolicy(tt.policy))
+			o.setTestTransport(func(req *http.Request) *http.Response {
+				code := tt.statusCodes[attempts]
+				attempts++
+				return &http.Response{
+					StatusCode: code,
+					Header:     http.Header{"Retry-After": []string{"0"}},
+					Body:       ioutil.NopCloser(bytes.NewBufferString("{}")),
+				}
+			})
+			req, _ := http.NewRequest(tt.method, "https://example.com/req1", nil)
+			resp, err := o.doRequest(req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Client.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if resp != nil {
+				resp.Body.Close()
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("Client.doRequest() attempts = %v, want %v", attempts, tt.wantAttempts)
+			}
+			var apiErr APIError
+			if errors.As(err, &apiErr) && apiErr.Attempts != tt.wantAttempts {
+				t.Errorf("APIError.Attempts = %v, want %v", apiErr.Attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestClient_doRequestRetryNetworkError(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+	o := NewClient("", WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond}))
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	_, err := o.doRequest(req)
+	var reqErr RequestError
+	if !errors.As(err, &reqErr) {
+		t.Fatalf("Client.doRequest() error = %v, want RequestError", err)
+	}
+	if reqErr.Attempts != 3 {
+		t.Errorf("RequestError.Attempts = %v, want %v", reqErr.Attempts, 3)
+	}
+	if !strings.Contains(err.Error(), "after 3 attempts") {
+		t.Errorf("RequestError.Error() = %v", err)
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := RetryPolicy{BaseBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt, want := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		if got := p.backoff(attempt+1, nil); got != want {
+			t.Errorf("RetryPolicy.backoff(%d) = %v, want %v", attempt+1, got, want)
+		}
+	}
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	if got := p.backoff(1, resp); got != time.Second {
+		t.Errorf("RetryPolicy.backoff() with Retry-After above MaxBackoff = %v, want %v", got, time.Second)
+	}
+	if got := (RetryPolicy{}).backoff(1, resp); got != 7*time.Second {
+		t.Errorf("RetryPolicy.backoff() with Retry-After = %v, want %v", got, 7*time.Second)
+	}
+	resp = &http.Response{Header: http.Header{"Retry-After": []string{"86400"}}}
+	if got := DefaultRetryPolicy().backoff(1, resp); got != 5*time.Second {
+		t.Errorf("RetryPolicy.backoff() with Retry-After of a day = %v, want %v", got, 5*time.Second)
+	}
+}
+
+func TestTokenBucket_Wait(t *testing.T) {
+	b := NewTokenBucket(100, 2)
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := b.Wait(context.Background()); err != nil {
+			t.Fatalf("TokenBucket.Wait() error = %v", err)
+		}
+	}
+	//2 requests from the burst, 2 more at 10ms each
+	if elapsed := time.Since(start); elapsed < 15*time.Millisecond {
+		t.Errorf("TokenBucket.Wait() elapsed = %v, want at least %v", elapsed, 15*time.Millisecond)
+	}
+
+	b = NewTokenBucket(0.001, 1)
+	if err := b.Wait(context.Background()); err != nil {
+		t.Fatalf("TokenBucket.Wait() error = %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := b.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("TokenBucket.Wait() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestClient_sharedRateLimiter(t *testing.T) {
+	limiter := NewTokenBucket(0.001, 1)
+	roundTrip := getRoundTripFunc("/stock/AAPL/price", http.StatusOK, 1.5)
+	o1 := NewClient("", WithRateLimiter(limiter))
+	o1.setTestTransport(roundTrip)
+	o2 := NewClient("", WithRateLimiter(limiter))
+	o2.setTestTransport(roundTrip)
+
+	if _, err := o1.PriceOnly("AAPL"); err != nil {
+		t.Fatalf("Client.PriceOnly() error = %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := o2.PriceOnlyContext(ctx, "AAPL"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Client.PriceOnlyContext() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestClient_MessagesUsed(t *testing.T) {
+	o := NewClient("", WithSandbox(), WithMessageBudget(5))
+	o.setTestTransport(func(req *http.Request) *http.Response {
+		resp := getRoundTripFunc("/stock/AAPL/", http.StatusOK, 1.5)(req)
+		if req.URL.Path == "/stable/stock/AAPL/ohlc" {
+			resp.Header.Set(messagesUsedHeader, "3")
+		}
+		return resp
+	})
+
+	if _, err := o.PriceOnly("AAPL"); err != nil {
+		t.Fatalf("Client.PriceOnly() error = %v", err)
+	}
+	if _, err := o.OHLC("AAPL"); err == nil {
+		t.Fatalf("Client.OHLC() error = nil, want unmarshal error")
+	}
+	if _, err := o.Metadata(); err == nil {
+		t.Fatalf("Client.Metadata() error = nil, want not found")
+	}
+	want := MessageUsage{
+		Total: 4,
+		ByEndpoint: map[string]int64{
+			"stock/price": 1,
+			"stock/ohlc":  3,
+		},
+	}
+	if got := o.MessagesUsed(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Client.MessagesUsed() = %v, want %v", got, want)
+	}
+
+	_, err := o.OHLC("AAPL")
+	var budgetErr BudgetExceededError
+	if !errors.As(err, &budgetErr) {
+		t.Fatalf("Client.OHLC() error = %v, want BudgetExceededError", err)
+	}
+	if budgetErr.Endpoint != "stock/ohlc" || budgetErr.Used != 4 || budgetErr.Weight != 2 {
+		t.Errorf("BudgetExceededError = %+v", budgetErr)
+	}
+	if _, err := o.PriceOnly("AAPL"); err != nil {
+		t.Errorf("Client.PriceOnly() error = %v", err)
+	}
+
+	o.ResetMessagesUsed()
+	if got := o.MessagesUsed(); got.Total != 0 || len(got.ByEndpoint) != 0 {
+		t.Errorf("Client.MessagesUsed() after reset = %v", got)
+	}
+}
+
+func TestClient_endpointKey(t *testing.T) {
+	o := NewClient("", WithSandbox())
+	tests := map[string]string{
+		"/stable/account/metadata":           "account/metadata",
+		"/stable/stock/AAPL/chart/5y":        "stock/chart",
+		"/stable/stock/AAPL/stats":           "stock/stats",
+		"/stable/stock/AAPL/stats/peRatio":   "stock/stats/stat",
+		"/stable/stock/market/batch":         "stock/batch",
+		"/stable/stock/AAPL":                 "stock",
+		"/stable/ref-data/region/US/symbols": "ref-data/region",
+		"/stable/crypto/BTCUSD/quote":        "crypto/quote",
+		"/stable/tops":                       "tops",
+	}
+	for path, want := range tests {
+		if got := o.endpointKey(path); got != want {
+			t.Errorf("Client.endpointKey(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
+
+func TestClient_BatchMessageBudget(t *testing.T) {
+	symbols := make([]string, 100)
+	for i := range symbols {
+		symbols[i] = fmt.Sprintf("S%d", i)
+	}
+	requests := 0
+	o := NewClient("", WithSandbox(), WithMessageBudget(1000))
+	o.setTestTransport(func(req *http.Request) *http.Response {
+		requests++
+		return getRoundTripFunc("/batch", http.StatusOK, map[string]*BatchResult{})(req)
+	})
+
+	_, err := o.Batch(BatchOption{Symbols: symbols, Types: []string{batchtype.AdvancedStats, batchtype.Quote}})
+	var budgetErr BudgetExceededError
+	if !errors.As(err, &budgetErr) {
+		t.Fatalf("Client.Batch() error = %v, want BudgetExceededError", err)
+	}
+	if budgetErr.Endpoint != "stock/batch" || budgetErr.Weight != 100*(3005+1) {
+		t.Errorf("BudgetExceededError = %+v", budgetErr)
+	}
+	if requests != 0 {
+		t.Errorf("Client.Batch() sent %v requests over budget", requests)
+	}
+
+	if _, err := o.Batch(BatchOption{Symbols: []string{"AAPL"}, Types: []string{batchtype.Quote, batchtype.Chart}}); err != nil {
+		t.Fatalf("Client.Batch() error = %v", err)
+	}
+	if got := o.MessagesUsed().ByEndpoint["stock/batch"]; got != 11 {
+		t.Errorf("Client.MessagesUsed() stock/batch = %v, want %v", got, 11)
+	}
+}
+
+func TestClient_Batch(t *testing.T) {
+	single := &BatchResult{}
+	getTestData(`{"quote":{"symbol":"AAPL","latestPrice":505.43},"chart":[{"date":"2020-08-17","close":470.7,"volume":30671436,"change":0,"changePercent":0,"changeOverTime":0}],"price":505.43}`, &single)
+	many := make([]string, 250)
+	for i := range many {
+		many[i] = fmt.Sprintf("S%d", i)
+	}
+	manyWant := make(map[string]*BatchResult, len(many))
+	for _, symbol := range many {
+		manyWant[symbol] = &BatchResult{Quote: &Quote{Symbol: symbol}}
+	}
+	type args struct {
+		option BatchOption
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      map[string]*BatchResult
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Single symbol",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: BatchOption{
+					Symbols: []string{"aapl"},
+					Types:   []string{batchtype.Quote, batchtype.Chart, batchtype.Price},
+					Chart: &HistoricalOption{
+						Range:     chartrange.Date,
+						ExactDate: "20200817",
+						ChartLast: 1,
+					},
+					Intraday: &IntradayOption{
+						ChartIEXOnly: true,
+					},
+					DisplayPercent: true,
+				},
+			},
+			want:      map[string]*BatchResult{"AAPL": single},
+			roundTrip: getRoundTripFunc("/stock/aapl/batch", http.StatusOK, single),
+			wantErr:   false,
+		},
+		{
+			name: "Chunked symbols",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: BatchOption{
+					Symbols: many,
+					Types:   []string{batchtype.Quote},
+				},
+			},
+			want: manyWant,
+			roundTrip: func(req *http.Request) *http.Response {
+				symbols := strings.Split(req.URL.Query().Get("symbols"), ",")
+				if len(symbols) > 100 {
+					return getRoundTripFunc("/", http.StatusBadRequest, "too many symbols")(req)
+				}
+				chunk := make(map[string]*BatchResult, len(symbols))
+				for _, symbol := range symbols {
+					chunk[symbol] = manyWant[symbol]
+				}
+				return getRoundTripFunc("/stock/market/batch", http.StatusOK, chunk)(req)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Lowercase symbols",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: BatchOption{
+					Symbols: []string{"aapl", "msft"},
+					Types:   []string{batchtype.Quote},
+				},
+			},
+			want: map[string]*BatchResult{"AAPL": {Quote: &Quote{Symbol: "AAPL"}}, "MSFT": {Quote: &Quote{Symbol: "MSFT"}}},
+			roundTrip: getRoundTripFunc("/stock/market/batch", http.StatusOK, map[string]*BatchResult{
+				"aapl": {Quote: &Quote{Symbol: "AAPL"}},
+				"msft": {Quote: &Quote{Symbol: "MSFT"}},
+			}),
+			wantErr: false,
+		},
+		{
+			name: "No symbols",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: BatchOption{
+					Types: []string{batchtype.Quote},
+				},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/market/batch", http.StatusOK, map[string]*BatchResult{}),
+			wantErr:   true,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				option: BatchOption{
+					Symbols: []string{"AAPL", "MSFT"},
+					Types:   []string{batchtype.Quote},
+				},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: BatchOption{
+					Symbols: []string{"AAPL"},
+					Types:   []string{batchtype.Quote},
+				},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/batch", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.Batch(tt.args.option)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.Batch() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Batch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Company(t *testing.T) {
+	d := &Company{}
+	getTestData(`{"symbol":"AAPL","companyName":"Apple Inc.","exchange":"NASDAQ","industry":"Telecommunications Equipment","website":"http://www.apple.com","description":"Apple, Inc. engages in the design, manufacture, and marketing of mobile communication, media devices, personal computers, and portable digital music players.","CEO":"Timothy Donald Cook","securityName":"Apple Inc.","issueType":"cs","sector":"Electronic Technology","primarySicCode":3663,"employees":132000,"tags":["Electronic Technology","Telecommunications Equipment"],"address":"One Apple Park Way","state":"CA","city":"Cupertino","zip":"95014-2083","country":"US","phone":"1.408.974.3123"}`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      *Company
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/company", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/company", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.Company(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.Company() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Company() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Logo(t *testing.T) {
+	d := &Logo{}
+	getTestData(`{"url":"https://storage.googleapis.com/iex/api/logos/AAPL.png"}`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      *Logo
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/logo", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/logo", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.Logo(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.Logo() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Logo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Peers(t *testing.T) {
+	var d []string
+	getTestData(`["MSFT","NOK","IBM","BBRY","HPQ","GOOGL","XLK"]`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []string
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/peers", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/peers", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.Peers(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.Peers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Peers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_InsiderRoster(t *testing.T) {
+	var d []*InsiderRoster
+	getTestData(`[{"entityName":"Levinson Arthur D","position":1350000,"reportDate":1546387200000}]`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*InsiderRoster
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/insider-roster", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/insider-roster", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.InsiderRoster(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.InsiderRoster() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.InsiderRoster() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_AdvancedStats(t *testing.T) {
+	d := &AdvancedStats{}
+	getTestData(`{"week52change":0.8145,"week52high":519.224,"week52low":207,"marketcap":2149873865922,"employees":137000,"day200MovingAvg":325.32,"day50MovingAvg":404.11,"float":4276528803,"avg10Volume":38823561,"avg30Volume":34856721,"ttmEPS":13.61,"ttmDividendRate":3.2,"companyName":"Apple, Inc.","sharesOutstanding":4275633923,"maxChangePercent":547.0163,"year5ChangePercent":3.2358,"year2ChangePercent":1.2749,"year1ChangePercent":0.8066,"ytdChangePercent":0.7364,"month6ChangePercent":0.6818,"month3ChangePercent":0.4452,"month1ChangePercent":0.2058,"day30ChangePercent":0.2185,"day5ChangePercent":0.0911,"nextDividendDate":"2020-08-14","dividendYield":0.0063,"nextEarningsDate":"2020-10-29","exDividendDate":"2020-08-07","peRatio":38.52,"beta":1.16,"totalCash":94051000000,"currentDebt":20421000000,"revenue":273857000000,"grossProfit":104789000000,"totalRevenue":273857000000,"EBITDA":80000000000,"revenuePerShare":63.76,"revenuePerEmployee":1998956.2,"debtToEquity":1.31,"profitMargin":0.2144,"enterpriseValue":2160000000000,"enterpriseValueToRevenue":7.87,"priceToSales":7.83,"priceToBook":29.49,"forwardPERatio":34.33,"pegRatio":3.11,"peHigh":39.58,"peLow":15.46,"week52highDate":"2020-08-21","week52lowDate":"2019-08-26","putCallRatio":0.53}`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      *AdvancedStats
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/advanced-stats", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/advanced-stats", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.AdvancedStats(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.AdvancedStats() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.AdvancedStats() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_KeyStats(t *testing.T) {
+	d := &KeyStats{}
+	getTestData(`{"companyName":"Apple, Inc.","marketcap":2149873865922,"week52high":519.224,"week52low":207,"week52change":0.8145,"sharesOutstanding":4275633923,"float":4276528803,"avg10Volume":38823561,"avg30Volume":34856721,"day200MovingAvg":325.32,"day50MovingAvg":404.11,"employees":137000,"ttmEPS":13.61,"ttmDividendRate":3.2,"dividendYield":0.0063,"nextDividendDate":"2020-08-14","exDividendDate":"2020-08-07","nextEarningsDate":"2020-10-29","peRatio":38.52,"beta":1.16}`, &d)
+	type args struct {
+		symbol string
+		stats  []string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      *KeyStats
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/stats", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Single stats",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+				stats:  []string{"peRatio", "nextEarningsDate"},
+			},
+			want: &KeyStats{PeRatio: 38.52, NextEarningsDate: "2020-10-29"},
+			roundTrip: func(req *http.Request) *http.Response {
+				if strings.HasSuffix(req.URL.Path, "/peRatio") {
+					return getRoundTripFunc("/stock/AAPL/stats/peRatio", http.StatusOK, 38.52)(req)
+				}
+				return getRoundTripFunc("/stock/AAPL/stats/nextEarningsDate", http.StatusOK, "2020-10-29")(req)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Plain text stats",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+				stats:  []string{"companyName", "nextEarningsDate", "beta"},
+			},
+			want: &KeyStats{CompanyName: "Apple, Inc.", NextEarningsDate: "2020-10-29", Beta: 1.16},
+			roundTrip: func(req *http.Request) *http.Response {
+				values := map[string]string{
+					"/stable/stock/AAPL/stats/companyName":      "Apple, Inc.",
+					"/stable/stock/AAPL/stats/nextEarningsDate": "2020-10-29\n",
+					"/stable/stock/AAPL/stats/beta":             "1.16",
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(strings.NewReader(values[req.URL.Path])),
+					Header:     make(http.Header),
+				}
+			},
+			wantErr: false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "AAPL",
+				stats:  []string{"peRatio"},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/stats", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+		{
+			name: "Unknown stat",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+				stats:  []string{"peRatio", "priceToEarnings"},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/stats/", http.StatusOK, 38.52),
+			wantErr:   true,
+		},
+		{
+			name: "Single stat failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+				stats:  []string{"peRatio"},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/stats/peRatio", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.KeyStats(tt.args.symbol, tt.args.stats...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.KeyStats() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.KeyStats() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_KeyStatsMessageWeight(t *testing.T) {
+	o := NewClient("", WithSandbox(), WithMessageBudget(5))
+	o.setTestTransport(getRoundTripFunc("/stock/AAPL/stats/", http.StatusOK, 1.16))
+	if _, err := o.KeyStats("AAPL", "beta", "peRatio"); err != nil {
+		t.Fatalf("Client.KeyStats() error = %v", err)
+	}
+	if got := o.MessagesUsed().ByEndpoint; got["stock/stats/stat"] != 2 || got["stock/stats"] != 0 {
+		t.Errorf("Client.MessagesUsed() = %v, want 2 single stat messages", got)
+	}
+}
+
+func TestAdvancedStats_EnterpriseValueToEBITDA(t *testing.T) {
+	a := &AdvancedStats{EnterpriseValue: 2160000000000, EBITDA: 80000000000}
+	if got := a.EnterpriseValueToEBITDA(); got != 27 {
+		t.Errorf("AdvancedStats.EnterpriseValueToEBITDA() = %v, want %v", got, 27)
+	}
+	if got := (&AdvancedStats{EnterpriseValue: 1}).EnterpriseValueToEBITDA(); got != 0 {
+		t.Errorf("AdvancedStats.EnterpriseValueToEBITDA() = %v, want %v", got, 0)
+	}
+}
+
+func TestClient_IncomeStatement(t *testing.T) {
+	var d []*IncomeStatement
+	getTestData(`[{"reportDate":"2020-07-31","filingType":"10-Q","fiscalDate":"2020-06-27","fiscalQuarter":3,"fiscalYear":2020,"currency":"USD","totalRevenue":59685000000,"costOfRevenue":37005000000,"grossProfit":22680000000,"researchAndDevelopment":4758000000,"sellingGeneralAndAdmin":4831000000,"operatingExpense":46594000000,"operatingIncome":13091000000,"otherIncomeExpenseNet":46000000,"ebit":13091000000,"interestIncome":697000000,"pretaxIncome":13137000000,"incomeTax":1884000000,"minorityInterest":0,"netIncome":11253000000,"netIncomeBasic":11253000000}]`, &d)
+	type args struct {
+		option FinancialOption
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*IncomeStatement
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: FinancialOption{
+					Symbol: "AAPL",
+					Period: period.Quarter,
+					Last:   1,
+				},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/income", http.StatusOK, incomeStatements{Symbol: "AAPL", Income: d}),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				option: FinancialOption{
+					Symbol: "AAPL",
+					Period: period.Quarter,
+					Last:   1,
+				},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: FinancialOption{
+					Symbol: "AAPL",
+					Period: period.Quarter,
+					Last:   1,
+				},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/income", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.IncomeStatement(tt.args.option)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.IncomeStatement() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.IncomeStatement() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_BalanceSheet(t *testing.T) {
+	var d []*BalanceSheet
+	getTestData(`[{"reportDate":"2019-10-31","filingType":"10-K","fiscalDate":"2019-09-28","fiscalQuarter":4,"fiscalYear":2019,"currency":"USD","currentCash":48844000000,"shortTermInvestments":51713000000,"receivables":45804000000,"inventory":4106000000,"currentAssets":162819000000,"totalAssets":338516000000,"accountsPayable":46236000000,"totalLiabilities":248028000000,"shareholderEquity":90488000000}]`, &d)
+	type args struct {
+		option FinancialOption
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*BalanceSheet
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: FinancialOption{
+					Symbol: "AAPL",
+					Period: period.Annual,
+				},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/balance-sheet", http.StatusOK, balanceSheets{Symbol: "AAPL", BalanceSheet: d}),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				option: FinancialOption{
+					Symbol: "AAPL",
+					Period: period.Annual,
+				},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: FinancialOption{
+					Symbol: "AAPL",
+					Period: period.Annual,
+				},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/balance-sheet", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.BalanceSheet(tt.args.option)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.BalanceSheet() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.BalanceSheet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_CashFlow(t *testing.T) {
+	var d []*CashFlow
+	getTestData(`[{"reportDate":"2020-07-31","filingType":"10-Q","fiscalDate":"2020-06-27","fiscalQuarter":3,"fiscalYear":2020,"currency":"USD","netIncome":11253000000,"depreciation":2752000000,"cashChange":-2197000000,"cashFlow":16271000000,"capitalExpenditures":-1565000000,"dividendsPaid":-3656000000}]`, &d)
+	type args struct {
+		option FinancialOption
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*CashFlow
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: FinancialOption{
+					Symbol: "AAPL",
+				},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/cash-flow", http.StatusOK, cashFlows{Symbol: "AAPL", CashFlow: d}),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				option: FinancialOption{
+					Symbol: "AAPL",
+				},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: FinancialOption{
+					Symbol: "AAPL",
+				},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/cash-flow", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.CashFlow(tt.args.option)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.CashFlow() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.CashFlow() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Financials(t *testing.T) {
+	var d []*Financials
+	getTestData(`[{"reportDate":"2020-07-31","fiscalDate":"2020-06-27","currency":"USD","grossProfit":22680000000,"costOfRevenue":37005000000,"operatingRevenue":59685000000,"totalRevenue":59685000000,"operatingIncome":13091000000,"netIncome":11253000000,"totalAssets":317344000000,"totalDebt":112436000000,"shareholderEquity":72282000000}]`, &d)
+	type args struct {
+		option FinancialOption
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*Financials
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: FinancialOption{
+					Symbol: "AAPL",
+					Period: period.Quarter,
+				},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/financials", http.StatusOK, financials{Symbol: "AAPL", Financials: d}),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				option: FinancialOption{
+					Symbol: "AAPL",
+					Period: period.Quarter,
+				},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: FinancialOption{
+					Symbol: "AAPL",
+					Period: period.Quarter,
+				},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/financials", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.Financials(tt.args.option)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.Financials() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Financials() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Fundamentals(t *testing.T) {
+	q3 := FiscalPeriod{ReportDate: "2020-07-31", FiscalDate: "2020-06-27", FiscalQuarter: 3, FiscalYear: 2020}
+	q2 := FiscalPeriod{ReportDate: "2020-05-01", FiscalDate: "2020-03-28", FiscalQuarter: 2, FiscalYear: 2020}
+	income := []*IncomeStatement{{FiscalPeriod: q2, NetIncome: 1}, {FiscalPeriod: q3, NetIncome: 2}}
+	balance := []*BalanceSheet{{FiscalPeriod: q3, TotalAssets: 3}}
+	cash := []*CashFlow{{FiscalPeriod: q3, CashFlow: 4}, {FiscalPeriod: q2, CashFlow: 5}}
+	roundTrip := func(req *http.Request) *http.Response {
+		switch {
+		case strings.HasSuffix(req.URL.Path, "/income"):
+			return getRoundTripFunc("/stock/AAPL/income", http.StatusOK, incomeStatements{Income: income})(req)
+		case strings.HasSuffix(req.URL.Path, "/balance-sheet"):
+			return getRoundTripFunc("/stock/AAPL/balance-sheet", http.StatusOK, balanceSheets{BalanceSheet: balance})(req)
+		}
+		return getRoundTripFunc("/stock/AAPL/cash-flow", http.StatusOK, cashFlows{CashFlow: cash})(req)
+	}
+	type args struct {
+		option FinancialOption
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*Fundamentals
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: FinancialOption{
+					Symbol: "AAPL",
+					Period: period.Quarter,
+					Last:   2,
+				},
+			},
+			want: []*Fundamentals{
+				{FiscalDate: q3.FiscalDate, ReportDate: q3.ReportDate, IncomeStatement: income[1], BalanceSheet: balance[0], CashFlow: cash[0]},
+				{FiscalDate: q2.FiscalDate, ReportDate: q2.ReportDate, IncomeStatement: income[0], CashFlow: cash[1]},
+			},
+			roundTrip: roundTrip,
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				option: FinancialOption{
+					Symbol: "AAPL",
+				},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: FinancialOption{
+					Symbol: "AAPL",
+				},
+			},
+			want: nil,
+			roundTrip: func(req *http.Request) *http.Response {
+				if strings.HasSuffix(req.URL.Path, "/cash-flow") {
+					return getRoundTripFunc("/stock/AAPL/cash-flow", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest})(req)
+				}
+				return roundTrip(req)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.Fundamentals(tt.args.option)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.Fundamentals() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Fundamentals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Dividends(t *testing.T) {
+	var d []*Dividend
+	getTestData(`[{"symbol":"AAPL","exDate":"2020-08-07","paymentDate":"2020-08-13","recordDate":"2020-08-10","declaredDate":"2020-07-30","amount":0.82,"flag":"Cash","currency":"USD","description":"Ordinary Shares","frequency":"quarterly"}]`, &d)
+	type args struct {
+		symbol      string
+		actionRange string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*Dividend
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol:      "AAPL",
+				actionRange: actionrange.OneYear,
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/dividends/1y", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol:      "AAPL",
+				actionRange: actionrange.OneYear,
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol:      "AAPL",
+				actionRange: actionrange.OneYear,
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/dividends/1y", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.Dividends(tt.args.symbol, tt.args.actionRange)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.Dividends() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Dividends() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Splits(t *testing.T) {
+	var d []*Split
+	getTestData(`[{"symbol":"AAPL","exDate":"2020-08-31","declaredDate":"2020-07-30","ratio":0.25,"toFactor":4,"fromFactor":1,"description":"4-for-1 split"}]`, &d)
+	type args struct {
+		symbol      string
+		actionRange string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*Split
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol:      "AAPL",
+				actionRange: actionrange.FiveYears,
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/splits/5y", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol:      "AAPL",
+				actionRange: actionrange.FiveYears,
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol:      "AAPL",
+				actionRange: actionrange.FiveYears,
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/splits/5y", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.Splits(tt.args.symbol, tt.args.actionRange)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.Splits() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Splits() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Earnings(t *testing.T) {
+	var d []*Earning
+	getTestData(`[{"actualEPS":2.58,"consensusEPS":2.04,"announceTime":"AMC","numberOfEstimates":10,"EPSSurpriseDollar":0.54,"EPSReportDate":"2020-07-30","fiscalPeriod":"Q3 2020","fiscalEndDate":"2020-06-30","yearAgo":2.18,"yearAgoChangePercent":0.1835}]`, &d)
+	type args struct {
+		symbol string
+		last   int
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*Earning
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+				last:   2,
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/earnings/2", http.StatusOK, earnings{Symbol: "AAPL", Earnings: d}),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "AAPL",
+				last:   2,
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+				last:   2,
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/earnings/2", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.Earnings(tt.args.symbol, tt.args.last)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.Earnings() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Earnings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_UpcomingEarnings(t *testing.T) {
+	var d []*UpcomingEarning
+	getTestData(`[{"symbol":"AAPL","reportDate":"2020-10-29","fiscalPeriod":"Q4 2020","fiscalEndDate":"2020-09-30"}]`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*UpcomingEarning
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "market",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/market/upcoming-earnings", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "market",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "market",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/market/upcoming-earnings", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.UpcomingEarnings(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.UpcomingEarnings() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.UpcomingEarnings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_UpcomingDividends(t *testing.T) {
+	var d []*Dividend
+	getTestData(`[{"symbol":"AAPL","exDate":"2020-11-06","paymentDate":"2020-11-12","amount":0.205,"currency":"USD","frequency":"quarterly"}]`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*Dividend
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/upcoming-dividends", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/upcoming-dividends", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.UpcomingDividends(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.UpcomingDividends() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.UpcomingDividends() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_UpcomingSplits(t *testing.T) {
+	var d []*Split
+	getTestData(`[{"symbol":"TSLA","exDate":"2020-08-31","ratio":0.2,"toFactor":5,"fromFactor":1}]`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*Split
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "market",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/market/upcoming-splits", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "market",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "market",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/market/upcoming-splits", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.UpcomingSplits(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.UpcomingSplits() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.UpcomingSplits() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_UpcomingIPOs(t *testing.T) {
+	d := &IPOCalendar{}
+	getTestData(`{"rawData":[{"symbol":"VCNX","companyName":"VACCINEX, INC.","expectedDate":"2018-08-09","leadUnderwriters":["BTIG, LLC"],"underwriters":["Ladenburg Thalmann & Co. Inc."],"market":"NASDAQ","employees":44,"status":"Filed","sharesOffered":3333000,"priceLow":12,"priceHigh":15}],"viewData":[{"Company":"VACCINEX, INC.","Symbol":"VCNX","Price":"$12.00 - 15.00","Shares":"3,333,000","Amount":"44,995,500","Float":"N/A","Percent":"N/A","Market":"NASDAQ","Expected":"2018-08-09"}]}`, &d)
+	tests := []struct {
+		name      string
+		o         *Client
+		want      *IPOCalendar
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name:      "Success",
+			o:         NewClient("", WithSandbox()),
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/market/upcoming-ipos", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "Request Failed",
+			o:         NewClient("", WithSandbox()),
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/market/upcoming-ipos", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.UpcomingIPOs()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.UpcomingIPOs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.UpcomingIPOs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Estimates(t *testing.T) {
+	var d []*Estimate
+	getTestData(`[{"consensusEPS":2.02,"announceTime":"AMC","numberOfEstimates":14,"reportDate":"2017-10-15","fiscalPeriod":"Q2 2017","fiscalEndDate":"2017-03-31","currency":"USD"}]`, &d)
+	type args struct {
+		option FinancialOption
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*Estimate
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: FinancialOption{
+					Symbol: "AAPL",
+					Period: period.Quarter,
+					Last:   1,
+				},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/estimates", http.StatusOK, estimates{Symbol: "AAPL", Estimates: d}),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				option: FinancialOption{
+					Symbol: "AAPL",
+					Period: period.Quarter,
+					Last:   1,
+				},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: FinancialOption{
+					Symbol: "AAPL",
+					Period: period.Quarter,
+					Last:   1,
+				},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/estimates", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.Estimates(tt.args.option)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.Estimates() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Estimates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_PriceTarget(t *testing.T) {
+	d := &PriceTarget{}
+	getTestData(`{"symbol":"AAPL","updatedDate":"2019-01-30","priceTargetAverage":178.59,"priceTargetHigh":245,"priceTargetLow":140,"numberOfAnalysts":34,"currency":"USD"}`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      *PriceTarget
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/price-target", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/price-target", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.PriceTarget(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.PriceTarget() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.PriceTarget() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_FundOwnership(t *testing.T) {
+	var d []*FundOwnership
+	getTestData(`[{"adjHolding":150,"adjMv":87,"entityProperName":"Vanguard Total Stock Market Index Fund","reportDate":1554969600000,"reportedHolding":900,"reportedMv":325}]`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*FundOwnership
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/fund-ownership", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/fund-ownership", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.FundOwnership(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.FundOwnership() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.FundOwnership() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_InstitutionalOwnership(t *testing.T) {
+	var d []*InstitutionalOwnership
+	getTestData(`[{"adjHolding":2046,"adjMv":86,"entityProperName":"Vanguard Group Inc","reportDate":1554969600000,"filingDate":"2019-04-12","reportedHolding":1024}]`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*InstitutionalOwnership
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/institutional-ownership", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/institutional-ownership", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.InstitutionalOwnership(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.InstitutionalOwnership() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.InstitutionalOwnership() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_InsiderTransactions(t *testing.T) {
+	var d []*InsiderTransaction
+	getTestData(`[{"effectiveDate":1522540800000,"fullName":"Joe Smith","reportedTitle":"Vice President","conversionOrExercisePrice":0,"directIndirect":"D","filingDate":"2018-04-03","postShares":12000,"transactionDate":"2018-04-01","tranCode":"S","tranPrice":200.5,"tranShares":-2000,"tranValue":401000}]`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*InsiderTransaction
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/insider-transactions", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/insider-transactions", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.InsiderTransactions(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.InsiderTransactions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.InsiderTransactions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_InsiderSummary(t *testing.T) {
+	var d []*InsiderSummary
+	getTestData(`[{"fullName":"Joe Smith","netTransacted":-15,"reportedTitle":"General Counsel","totalBought":0,"totalSold":-15}]`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*InsiderSummary
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/insider-summary", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/insider-summary", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.InsiderSummary(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.InsiderSummary() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.InsiderSummary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_RecommendationTrends(t *testing.T) {
+	var d []*RecommendationTrend
+	getTestData(`[{"consensusEndDate":1542240000000,"consensusStartDate":1541462400000,"corporateActionsAppliedDate":1055721600000,"ratingBuy":8,"ratingHold":1,"ratingNone":2,"ratingOverweight":2,"ratingScaleMark":1.042,"ratingSell":1,"ratingUnderweight":1}]`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*RecommendationTrend
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/recommendation-trends", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/recommendation-trends", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.RecommendationTrends(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.RecommendationTrends() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.RecommendationTrends() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_News(t *testing.T) {
+	var d []*NewsItem
+	getTestData(`[{"datetime":1545215400000,"headline":"Voice Search Technology Creates A New Paradigm For Marketers","source":"Benzinga","url":"https://cloud.iexapis.com/stable/news/article/8348646549980454","summary":"Voice search is likely to grow by leap and bounds","related":"AAPL,AMZN,GOOG,GOOGL,MSFT","image":"https://cloud.iexapis.com/stable/news/image/7594023985414148","lang":"en","hasPaywall":true}]`, &d)
+	type args struct {
+		symbol string
+		last   int
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*NewsItem
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+				last:   1,
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/news/last/1", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "AAPL",
+				last:   1,
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+				last:   1,
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/news/last/1", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.News(tt.args.symbol, tt.args.last)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.News() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.News() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_MarketNews(t *testing.T) {
+	var d []*NewsItem
+	getTestData(`[{"datetime":1545215400000,"headline":"Market wrap","source":"Benzinga","url":"https://cloud.iexapis.com/stable/news/article/1","related":"SPY","lang":"en","hasPaywall":false}]`, &d)
+	type args struct {
+		last int
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*NewsItem
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				last: 1,
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/market/news/last/1", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				last: 1,
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				last: 1,
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/market/news/last/1", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.MarketNews(tt.args.last)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.MarketNews() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.MarketNews() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewsItem_RelatedSymbols(t *testing.T) {
+	n := &NewsItem{Related: "AAPL, MSFT,,GOOG"}
+	if got, want := n.RelatedSymbols(), []string{"AAPL", "MSFT", "GOOG"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("NewsItem.RelatedSymbols() = %v, want %v", got, want)
+	}
+	if got := (&NewsItem{}).RelatedSymbols(); got != nil {
+		t.Errorf("NewsItem.RelatedSymbols() = %v, want nil", got)
+	}
+}
+
+func TestClient_List(t *testing.T) {
+	var d []*Quote
+	getTestData(`[{"symbol":"AAPL","companyName":"Apple, Inc.","calculationPrice":"tops","open":154,"latestPrice":158.73,"latestSource":"Previous close","latestTime":"September 19, 2017","latestVolume":20567140,"previousClose":158.73,"change":3.24,"changePercent":2.06,"avgTotalVolume":29623234,"marketCap":751627174400,"peRatio":16.86,"week52High":159.65,"week52Low":93.63,"ytdChange":0.3665,"isUSMarketOpen":true}]`, &d)
+	type args struct {
+		listType string
+		option   ListOption
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*Quote
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				listType: listtype.Gainers,
+				option: ListOption{
+					DisplayPercent: true,
+					ListLimit:      1,
+				},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/market/list/gainers", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				listType: listtype.Gainers,
+				option: ListOption{
+					DisplayPercent: true,
+					ListLimit:      1,
+				},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				listType: listtype.Gainers,
+				option: ListOption{
+					DisplayPercent: true,
+					ListLimit:      1,
+				},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/market/list/gainers", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.List(tt.args.listType, tt.args.option)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.List() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.List() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_SectorPerformance(t *testing.T) {
+	var d []*SectorPerformance
+	getTestData(`[{"type":"sector","name":"Industrials","performance":0.00711,"lastUpdated":1533672000437}]`, &d)
+	tests := []struct {
+		name      string
+		o         *Client
+		want      []*SectorPerformance
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name:      "Success",
+			o:         NewClient("", WithSandbox()),
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/market/sector-performance", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "Request Failed",
+			o:         NewClient("", WithSandbox()),
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/market/sector-performance", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.SectorPerformance()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.SectorPerformance() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.SectorPerformance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_MarketVolume(t *testing.T) {
+	var d []*MarketVolume
+	getTestData(`[{"mic":"TRF","tapeId":"-","venueName":"TRF Volume","volume":589171705,"tapeA":305187928,"tapeB":119650027,"tapeC":164333750,"marketPercent":0.37027,"lastUpdated":1480433817317}]`, &d)
+	tests := []struct {
+		name      string
+		o         *Client
+		want      []*MarketVolume
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name:      "Success",
+			o:         NewClient("", WithSandbox()),
+			want:      d,
+			roundTrip: getRoundTripFunc("/market", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "Request Failed",
+			o:         NewClient("", WithSandbox()),
+			want:      nil,
+			roundTrip: getRoundTripFunc("/market", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.MarketVolume()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.MarketVolume() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.MarketVolume() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Symbols(t *testing.T) {
+	var d []*Symbol
+	getTestData(`[{"symbol":"A","exchange":"NYS","exchangeSuffix":"UN","exchangeName":"New York Stock Exchange Inc","name":"Agilent Technologies Inc.","date":"2020-08-24","type":"cs","iexId":"IEX_46574843354B2D52","region":"US","currency":"USD","isEnabled":true,"figi":"BBG000C2V3D6","cik":"1090872"}]`, &d)
+	tests := []struct {
+		name      string
+		o         *Client
+		want      []*Symbol
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name:      "Success",
+			o:         NewClient("", WithSandbox()),
+			want:      d,
+			roundTrip: getRoundTripFunc("/ref-data/symbols", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "Request Failed",
+			o:         NewClient("", WithSandbox()),
+			want:      nil,
+			roundTrip: getRoundTripFunc("/ref-data/symbols", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.Symbols()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.Symbols() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Symbols() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_IEXSymbols(t *testing.T) {
+	var d []*IEXSymbol
+	getTestData(`[{"symbol":"A","date":"2020-08-24","isEnabled":true}]`, &d)
+	tests := []struct {
+		name      string
+		o         *Client
+		want      []*IEXSymbol
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name:      "Success",
+			o:         NewClient("", WithSandbox()),
+			want:      d,
+			roundTrip: getRoundTripFunc("/ref-data/iex/symbols", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "Request Failed",
+			o:         NewClient("", WithSandbox()),
+			want:      nil,
+			roundTrip: getRoundTripFunc("/ref-data/iex/symbols", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.IEXSymbols()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.IEXSymbols() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.IEXSymbols() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_OTCSymbols(t *testing.T) {
+	var d []*Symbol
+	getTestData(`[{"symbol":"AAAIF","exchange":"OTC","name":"Alternative Investment Trust","type":"cs","region":"US","currency":"USD","isEnabled":true}]`, &d)
+	tests := []struct {
+		name      string
+		o         *Client
+		want      []*Symbol
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name:      "Success",
+			o:         NewClient("", WithSandbox()),
+			want:      d,
+			roundTrip: getRoundTripFunc("/ref-data/otc/symbols", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "Request Failed",
+			o:         NewClient("", WithSandbox()),
+			want:      nil,
+			roundTrip: getRoundTripFunc("/ref-data/otc/symbols", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.OTCSymbols()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.OTCSymbols() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.OTCSymbols() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_MutualFundSymbols(t *testing.T) {
+	var d []*Symbol
+	getTestData(`[{"symbol":"AAAAX","exchange":"MUTUAL","name":"DWS RREEF Real Assets Fund - Class A","type":"oef","region":"US","currency":"USD","isEnabled":true}]`, &d)
+	tests := []struct {
+		name      string
+		o         *Client
+		want      []*Symbol
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name:      "Success",
+			o:         NewClient("", WithSandbox()),
+			want:      d,
+			roundTrip: getRoundTripFunc("/ref-data/mutual-funds/symbols", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "Request Failed",
+			o:         NewClient("", WithSandbox()),
+			want:      nil,
+			roundTrip: getRoundTripFunc("/ref-data/mutual-funds/symbols", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.MutualFundSymbols()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.MutualFundSymbols() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.MutualFundSymbols() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Exchanges(t *testing.T) {
+	var d []*Exchange
+	getTestData(`[{"exchange":"ADS","region":"AE","description":"Abu Dhabi Securities Exchange","mic":"XADS","exchangeSuffix":"-DH"}]`, &d)
+	tests := []struct {
+		name      string
+		o         *Client
+		want      []*Exchange
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name:      "Success",
+			o:         NewClient("", WithSandbox()),
+			want:      d,
+			roundTrip: getRoundTripFunc("/ref-data/exchanges", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "Request Failed",
+			o:         NewClient("", WithSandbox()),
+			want:      nil,
+			roundTrip: getRoundTripFunc("/ref-data/exchanges", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.Exchanges()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.Exchanges() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Exchanges() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_RegionSymbols(t *testing.T) {
+	var d []*Symbol
+	getTestData(`[{"symbol":"A-CV","exchange":"TSX","name":"Armor Minerals Inc","type":"cs","region":"CA","currency":"CAD","isEnabled":true}]`, &d)
+	type args struct {
+		region string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*Symbol
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				region: "ca",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/ref-data/region/ca/symbols", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				region: "ca",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				region: "ca",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/ref-data/region/ca/symbols", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.RegionSymbols(tt.args.region)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.RegionSymbols() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.RegionSymbols() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Sectors(t *testing.T) {
+	var d []*Sector
+	getTestData(`[{"name":"Electronic Technology"},{"name":"Distribution Services"}]`, &d)
+	tests := []struct {
+		name      string
+		o         *Client
+		want      []*Sector
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name:      "Success",
+			o:         NewClient("", WithSandbox()),
+			want:      d,
+			roundTrip: getRoundTripFunc("/ref-data/sectors", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "Request Failed",
+			o:         NewClient("", WithSandbox()),
+			want:      nil,
+			roundTrip: getRoundTripFunc("/ref-data/sectors", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.Sectors()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.Sectors() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Sectors() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Tags(t *testing.T) {
+	var d []*Tag
+	getTestData(`[{"name":"Electronic Technology"},{"name":"Telecommunications Equipment"}]`, &d)
+	tests := []struct {
+		name      string
+		o         *Client
+		want      []*Tag
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name:      "Success",
+			o:         NewClient("", WithSandbox()),
+			want:      d,
+			roundTrip: getRoundTripFunc("/ref-data/tags", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "Request Failed",
+			o:         NewClient("", WithSandbox()),
+			want:      nil,
+			roundTrip: getRoundTripFunc("/ref-data/tags", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.Tags()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.Tags() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Tags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_USDates(t *testing.T) {
+	var d []*USDate
+	getTestData(`[{"date":"2020-11-26","settlementDate":"2020-11-30"}]`, &d)
+	type args struct {
+		option USDateOption
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*USDate
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: USDateOption{
+					Type:      datetype.Holiday,
+					Direction: direction.Next,
+					Last:      1,
+					StartDate: "20201101",
+				},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/ref-data/us/dates/holiday/next/1/20201101", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				option: USDateOption{
+					Type:      datetype.Holiday,
+					Direction: direction.Next,
+					Last:      1,
+					StartDate: "20201101",
+				},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				option: USDateOption{
+					Type:      datetype.Holiday,
+					Direction: direction.Next,
+					Last:      1,
+					StartDate: "20201101",
+				},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/ref-data/us/dates/holiday/next/1/20201101", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.USDates(tt.args.option)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.USDates() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.USDates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSymbolIndex(t *testing.T) {
+	symbols := []*Symbol{
+		{Symbol: "AAPL", Exchange: "NAS", Name: "Apple Inc", Type: "cs"},
+		{Symbol: "APLE", Exchange: "NYS", Name: "Apple Hospitality REIT Inc", Type: "cs"},
+		{Symbol: "MSFT", Exchange: "NAS", Name: "Microsoft Corporation", Type: "cs"},
+		{Symbol: "SPY", Exchange: "PSE", Name: "SPDR S&P 500 ETF Trust", Type: "et"},
+	}
+	idx := NewSymbolIndex(symbols)
+	if idx.Len() != 4 {
+		t.Errorf("SymbolIndex.Len() = %v, want %v", idx.Len(), 4)
+	}
+	if got, ok := idx.Lookup("aapl"); !ok || got != symbols[0] {
+		t.Errorf("SymbolIndex.Lookup() = %v, %v", got, ok)
+	}
+	if _, ok := idx.Lookup("GOOG"); ok {
+		t.Errorf("SymbolIndex.Lookup() found unknown symbol")
+	}
+	if got, want := idx.ByNamePrefix("APPLE"), []*Symbol{symbols[1], symbols[0]}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SymbolIndex.ByNamePrefix() = %v, want %v", got, want)
+	}
+	if got := idx.ByNamePrefix("Z"); got != nil {
+		t.Errorf("SymbolIndex.ByNamePrefix() = %v, want nil", got)
+	}
+	if got, want := idx.ByExchange("nas"), []*Symbol{symbols[0], symbols[2]}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SymbolIndex.ByExchange() = %v, want %v", got, want)
+	}
+	if got, want := idx.ByType("ET"), []*Symbol{symbols[3]}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SymbolIndex.ByType() = %v, want %v", got, want)
+	}
+}
+
+func TestClient_CryptoBook(t *testing.T) {
+	d := &CryptoBook{}
+	getTestData(`{"symbol":"BTCUSD","bids":[{"price":"11450.65","size":"0.036","timestamp":1598374494050}],"asks":[{"price":"11451.9","size":"0.5","timestamp":1598374494095}]}`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      *CryptoBook
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "BTCUSD",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/crypto/BTCUSD/book", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "BTCUSD",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "BTCUSD",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/crypto/BTCUSD/book", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.CryptoBook(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.CryptoBook() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.CryptoBook() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_CryptoPrice(t *testing.T) {
+	d := &CryptoPrice{}
+	getTestData(`{"price":"11451.47","symbol":"BTCUSD"}`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      *CryptoPrice
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "BTCUSD",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/crypto/BTCUSD/price", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "BTCUSD",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "BTCUSD",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/crypto/BTCUSD/price", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.CryptoPrice(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.CryptoPrice() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.CryptoPrice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_CryptoQuote(t *testing.T) {
+	d := &CryptoQuote{}
+	getTestData(`{"symbol":"BTCUSD","primaryExchange":"","sector":"cryptocurrency","calculationPrice":"realtime","latestPrice":"11451.47","latestSource":"Real time price","latestUpdate":1598374495000,"latestVolume":"0.012","bidPrice":"11450.65","bidSize":"0.036","askPrice":"11451.9","askSize":"0.5","high":null,"low":null,"previousClose":null}`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      *CryptoQuote
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "BTCUSD",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/crypto/BTCUSD/quote", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "BTCUSD",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "BTCUSD",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/crypto/BTCUSD/quote", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.CryptoQuote(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.CryptoQuote() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.CryptoQuote() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_CryptoSymbols(t *testing.T) {
+	var d []*Symbol
+	getTestData(`[{"symbol":"BTCUSD","name":"Bitcoin to USD","exchange":"","date":"2020-08-25","type":"crypto","iexId":"BTCUSD","region":"US","currency":"USD","isEnabled":true}]`, &d)
+	tests := []struct {
+		name      string
+		o         *Client
+		want      []*Symbol
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name:      "Success",
+			o:         NewClient("", WithSandbox()),
+			want:      d,
+			roundTrip: getRoundTripFunc("/ref-data/crypto/symbols", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "Request Failed",
+			o:         NewClient("", WithSandbox()),
+			want:      nil,
+			roundTrip: getRoundTripFunc("/ref-data/crypto/symbols", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.CryptoSymbols()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.CryptoSymbols() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.CryptoSymbols() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_FXLatest(t *testing.T) {
+	var d []*ExchangeRate
+	getTestData(`[{"symbol":"USDCAD","rate":1.31,"timestamp":1288282222000},{"symbol":"USDGBP","rate":0.755,"timestamp":1288282222000}]`, &d)
+	type args struct {
+		pairs []CurrencyPair
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*ExchangeRate
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				pairs: []CurrencyPair{{From: "USD", To: "CAD"}, {From: "USD", To: "GBP"}},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/fx/latest", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name:      "No pairs",
+			o:         NewClient("", WithSandbox()),
+			args:      args{},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/fx/latest", http.StatusOK, d),
+			wantErr:   true,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				pairs: []CurrencyPair{{From: "USD", To: "CAD"}, {From: "USD", To: "GBP"}},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				pairs: []CurrencyPair{{From: "USD", To: "CAD"}, {From: "USD", To: "GBP"}},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/fx/latest", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.FXLatest(tt.args.pairs...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.FXLatest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.FXLatest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_FXConvert(t *testing.T) {
+	var d []*ConvertedAmount
+	getTestData(`[{"symbol":"USDCAD","rate":1.31,"timestamp":1288282222000,"amount":108.73}]`, &d)
+	type args struct {
+		pairs  []CurrencyPair
+		amount float64
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*ConvertedAmount
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				pairs:  []CurrencyPair{{From: "USD", To: "CAD"}},
+				amount: 83,
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/fx/convert", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "No pairs",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				amount: 83,
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/fx/convert", http.StatusOK, d),
+			wantErr:   true,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				pairs:  []CurrencyPair{{From: "USD", To: "CAD"}},
+				amount: 83,
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				pairs:  []CurrencyPair{{From: "USD", To: "CAD"}},
+				amount: 83,
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/fx/convert", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.FXConvert(tt.args.pairs, tt.args.amount)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.FXConvert() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.FXConvert() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_FXSymbols(t *testing.T) {
+	d := &FXSymbols{}
+	getTestData(`{"currencies":[{"code":"AUD","name":"Australian Dollar"},{"code":"USD","name":"U.S. Dollar"}],"pairs":[{"fromCurrency":"AUD","toCurrency":"USD","symbol":"AUDUSD"}]}`, &d)
+	tests := []struct {
+		name      string
+		o         *Client
+		want      *FXSymbols
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name:      "Success",
+			o:         NewClient("", WithSandbox()),
+			want:      d,
+			roundTrip: getRoundTripFunc("/ref-data/fx/symbols", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "Request Failed",
+			o:         NewClient("", WithSandbox()),
+			want:      nil,
+			roundTrip: getRoundTripFunc("/ref-data/fx/symbols", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.FXSymbols()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.FXSymbols() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.FXSymbols() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_FXHistorical(t *testing.T) {
+	want := []*HistoricalRate{}
+	getTestData(`[{"date":"2019-01-02","symbol":"USDCAD","rate":1.3603,"timestamp":1546387200000},{"date":"2019-01-02","symbol":"USDGBP","rate":0.7869,"timestamp":1546387200000},{"date":"2019-01-03","symbol":"USDGBP","rate":0.7906,"timestamp":1546473600000}]`, &want)
+	data := `[[{"date":"2019-01-02","symbol":"USDCAD","rate":1.3603,"timestamp":1546387200000}],[{"date":"2019-01-02","symbol":"USDGBP","rate":0.7869,"timestamp":1546387200000},{"date":"2019-01-03","symbol":"USDGBP","rate":0.7906,"timestamp":1546473600000}]]`
+	o := NewClient("", WithSandbox())
+	o.setTestTransport(func(req *http.Request) *http.Response {
+		if req.URL.Path != "/stable/fx/historical" {
+			t.Errorf("request path = %v", req.URL.Path)
+		}
+		if got := req.URL.Query(); got.Get("symbols") != "USDCAD,USDGBP" || got.Get("from") != "2019-01-01" || got.Get("to") != "2019-01-03" {
+			t.Errorf("request query = %v", got)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(data)),
+			Header:     make(http.Header),
+		}
+	})
+	got, err := o.FXHistorical([]CurrencyPair{{From: "USD", To: "CAD"}, {From: "USD", To: "GBP"}}, "2019-01-01", "2019-01-03")
+	if err != nil {
+		t.Fatalf("Client.FXHistorical() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Client.FXHistorical() = %v, want %v", got, want)
+	}
+	if _, err := o.FXHistorical(nil, "2019-01-01", "2019-01-03"); err == nil {
+		t.Errorf("Client.FXHistorical() expected error without pairs")
+	}
+}
+
+func TestConverter(t *testing.T) {
+	c, err := NewConverter([]*ExchangeRate{{Symbol: "USDEUR", Rate: 0.8}, {Symbol: "GBPUSD", Rate: 1.25}})
+	if err != nil {
+		t.Fatalf("NewConverter() error = %v", err)
+	}
+	tests := []struct {
+		name    string
+		quote   *Quote
+		to      string
+		want    float64
+		wantErr bool
+	}{
+		{"Direct rate", &Quote{LatestPrice: 100}, "EUR", 80, false},
+		{"Inverse rate", &Quote{LatestPrice: 100, Currency: "USD"}, "gbp", 80, false},
+		{"Same currency", &Quote{LatestPrice: 100, Currency: "EUR"}, "EUR", 100, false},
+		{"Missing rate", &Quote{LatestPrice: 100}, "JPY", 0, true},
+		{"Nil quote", nil, "EUR", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ConvertQuote(tt.quote, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Converter.ConvertQuote() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Converter.ConvertQuote() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if err := c.Update([]*ExchangeRate{{Symbol: "USDJPY", Rate: 150}}); err != nil {
+		t.Fatalf("Converter.Update() error = %v", err)
+	}
+	if got, err := c.Convert(2, "USD", "JPY"); err != nil || got != 300 {
+		t.Errorf("Converter.Convert() = %v, %v", got, err)
+	}
+	if _, err := NewConverter([]*ExchangeRate{{Symbol: "USD", Rate: 1}}); err == nil {
+		t.Errorf("NewConverter() expected error for invalid pair")
+	}
+}
+
+func TestClient_OptionExpirations(t *testing.T) {
+	var d []string
+	getTestData(`["20190621","20190719","20190816"]`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []string
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/options", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/options", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.OptionExpirations(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.OptionExpirations() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.OptionExpirations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_OptionChain(t *testing.T) {
+	var d []*OptionContract
+	getTestData(`[{"symbol":"AAPL","id":"AAPL20190621C00240000","expirationDate":"20190621","contractSize":100,"strikePrice":240,"closingPrice":0.01,"side":"call","type":"equity","volume":10,"openInterest":1523,"bid":0,"ask":0.01,"impliedVolatility":0.32,"delta":0.02,"lastUpdated":"2019-05-10","isAdjusted":false}]`, &d)
+	type args struct {
+		symbol     string
+		expiration string
+		side       string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*OptionContract
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol:     "AAPL",
+				expiration: "20190621",
+				side:       optionside.Call,
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/stock/AAPL/options/20190621/call", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol:     "AAPL",
+				expiration: "20190621",
+				side:       optionside.Call,
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol:     "AAPL",
+				expiration: "20190621",
+				side:       optionside.Call,
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/stock/AAPL/options/20190621/call", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.OptionChain(tt.args.symbol, tt.args.expiration, tt.args.side)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.OptionChain() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.OptionChain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptionContract_JSON(t *testing.T) {
+	var c OptionContract
+	if err := json.Unmarshal([]byte(`{"id":"AAPL20190621P00170000","expirationDate":"20190621","strikePrice":170,"side":"put"}`), &c); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if want := time.Date(2019, time.June, 21, 0, 0, 0, 0, time.UTC); !c.Expiration.Equal(want) || c.StrikePrice != 170 || c.Delta != nil {
+		t.Errorf("OptionContract = %+v", c)
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(b), `"expirationDate":"20190621"`) {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+	if err := json.Unmarshal([]byte(`{"id":"AAPL","expirationDate":"2019-06"}`), &c); err == nil {
+		t.Errorf("json.Unmarshal() expected error for invalid expiration")
+	}
+}
+
+func TestClient_DataPoints(t *testing.T) {
+	var d []*DataPoint
+	getTestData(`[{"key":"QUOTE-LATESTPRICE","weight":1,"description":"Latest price","lastUpdated":"2020-08-24T20:00:00+00:00"}]`, &d)
+	type args struct {
+		symbol string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*DataPoint
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/data-points/AAPL", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbol: "AAPL",
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/data-points/AAPL", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.DataPoints(tt.args.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.DataPoints() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.DataPoints() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_TimeSeries(t *testing.T) {
+	type treasury struct {
+		Date  int64   `json:"date"`
+		Value float64 `json:"value"`
+	}
+	data := `[{"date":1598227200000,"value":0.65,"id":"TREASURY","key":"DGS10"}]`
+	tests := []struct {
+		name      string
+		option    TimeSeriesOption
+		wantPath  string
+		wantQuery string
+	}{
+		{"ID only", TimeSeriesOption{ID: "TREASURY"}, "/stable/time-series/TREASURY", "token=test"},
+		{"Key and sub key", TimeSeriesOption{ID: "REPORTED_FINANCIALS", Key: "AAPL", SubKey: "10-Q", Last: 2}, "/stable/time-series/REPORTED_FINANCIALS/AAPL/10-Q", "last=2&token=test"},
+		{"All parameters", TimeSeriesOption{
+			ID:           "TREASURY",
+			Key:          "DGS10",
+			Range:        "1m",
+			From:         "2020-08-01",
+			To:           "2020-08-24",
+			On:           "2020-08-24",
+			First:        1,
+			Calendar:     true,
+			Limit:        5,
+			Subattribute: "source|WSJ",
+			DateField:    "reportDate",
+		}, "/stable/time-series/TREASURY/DGS10", "calendar=true&dateField=reportDate&first=1&from=2020-08-01&limit=5&on=2020-08-24&range=1m&subattribute=source%7CWSJ&to=2020-08-24&token=test"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewClient("test", WithSandbox())
+			o.setTestTransport(func(req *http.Request) *http.Response {
+				if req.URL.Path != tt.wantPath || req.URL.RawQuery != tt.wantQuery {
+					t.Errorf("request = %v?%v, want %v?%v", req.URL.Path, req.URL.RawQuery, tt.wantPath, tt.wantQuery)
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(strings.NewReader(data)),
+					Header:     make(http.Header),
+				}
+			})
+			var typed []treasury
+			if err := o.TimeSeries(tt.option, &typed); err != nil {
+				t.Fatalf("Client.TimeSeries() error = %v", err)
+			}
+			if want := []treasury{{Date: 1598227200000, Value: 0.65}}; !reflect.DeepEqual(typed, want) {
+				t.Errorf("Client.TimeSeries() = %v, want %v", typed, want)
+			}
+			var generic []map[string]interface{}
+			if err := o.TimeSeries(tt.option, &generic); err != nil {
+				t.Fatalf("Client.TimeSeries() error = %v", err)
+			}
+			if len(generic) != 1 || generic[0]["key"] != "DGS10" {
+				t.Errorf("Client.TimeSeries() = %v", generic)
+			}
+		})
+	}
+	if err := (&Client{baseURL: "://"}).TimeSeries(TimeSeriesOption{ID: "TREASURY"}, &[]treasury{}); err == nil {
+		t.Errorf("Client.TimeSeries() expected error for invalid base URL")
+	}
+	o := NewClient("test", WithSandbox())
+	o.setTestTransport(func(req *http.Request) *http.Response {
+		t.Errorf("unexpected request %v", req.URL)
+		return nil
+	})
+	var nilOut *[]treasury
+	for _, out := range []interface{}{[]map[string]interface{}{}, nil, nilOut} {
+		if err := o.TimeSeries(TimeSeriesOption{ID: "TREASURY"}, out); err == nil {
+			t.Errorf("Client.TimeSeries() expected error for out %T", out)
+		}
+	}
+}
+
+func TestClient_DataPoint(t *testing.T) {
+	o := NewClient("", WithSandbox())
+	o.setTestTransport(func(req *http.Request) *http.Response {
+		if req.URL.Path != "/stable/data-points/AAPL/QUOTE-LATESTPRICE" {
+			t.Errorf("request path = %v", req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader("503.43")),
+			Header:     make(http.Header),
+		}
+	})
+	got, err := o.DataPoint("AAPL", "QUOTE-LATESTPRICE")
+	if err != nil || got != "503.43" {
+		t.Errorf("Client.DataPoint() = %v, %v", got, err)
+	}
+	o.setTestTransport(getRoundTripFunc("/data-points/AAPL/QUOTE-LATESTPRICE", http.StatusForbidden, nil))
+	if _, err := o.DataPoint("AAPL", "QUOTE-LATESTPRICE"); err == nil {
+		t.Errorf("Client.DataPoint() expected error")
+	}
+	if _, err := (&Client{baseURL: "://"}).DataPoint("AAPL", "QUOTE-LATESTPRICE"); err == nil {
+		t.Errorf("Client.DataPoint() expected error for invalid base URL")
+	}
+}
+
+func TestClient_EconomicData(t *testing.T) {
+	tests := []struct {
+		name     string
+		latest   func(o *Client) (*EconomicValue, error)
+		history  func(o *Client) ([]*EconomicValue, error)
+		wantPath string
+	}{
+		{"TreasuryRate", func(o *Client) (*EconomicValue, error) {
+			return o.TreasuryRate(treasury.Rate10Year)
+		}, func(o *Client) ([]*EconomicValue, error) {
+			return o.TreasuryRateHistory(treasury.Rate10Year, TimeSeriesOption{ID: "ignored", Range: "1m"})
+		}, "/stable/time-series/treasury/DGS10"},
+		{"Commodity", func(o *Client) (*EconomicValue, error) {
+			return o.Commodity(commodity.WTI)
+		}, func(o *Client) ([]*EconomicValue, error) {
+			return o.CommodityHistory(commodity.WTI, TimeSeriesOption{Range: "1m"})
+		}, "/stable/time-series/energy/DCOILWTICO"},
+		{"EconomicIndicator", func(o *Client) (*EconomicValue, error) {
+			return o.EconomicIndicator(economic.CPI)
+		}, func(o *Client) ([]*EconomicValue, error) {
+			return o.EconomicIndicatorHistory(economic.CPI, TimeSeriesOption{SubKey: "ignored", Range: "1m"})
+		}, "/stable/time-series/economic/CPIAUCSL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := `[{"value":0.65,"id":"X","key":"K","subkey":"NONE","date":1598227200000,"updated":1598288498000},{"value":0.62,"id":"X","key":"K","subkey":"NONE","date":1598140800000,"updated":1598202098000}]`
+			o := NewClient("", WithSandbox())
+			o.setTestTransport(func(req *http.Request) *http.Response {
+				if req.URL.Path != tt.wantPath {
+					t.Errorf("request path = %v, want %v", req.URL.Path, tt.wantPath)
+				}
+				body := data
+				switch req.URL.Query().Get("last") {
+				case "1":
+					body = `[{"value":0.65,"key":"K","date":1598227200000}]`
+				case "":
+					if req.URL.Query().Get("range") != "1m" {
+						t.Errorf("request query = %v", req.URL.RawQuery)
+					}
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(strings.NewReader(body)),
+					Header:     make(http.Header),
+				}
+			})
+			got, err := tt.latest(o)
+			if err != nil {
+				t.Fatalf("latest error = %v", err)
+			}
+			if want := (&EconomicValue{Key: "K", Value: 0.65, Date: EpochTime(time.Unix(1598227200, 0))}); !reflect.DeepEqual(got, want) {
+				t.Errorf("latest = %v, want %v", got, want)
+			}
+			history, err := tt.history(o)
+			if err != nil {
+				t.Fatalf("history error = %v", err)
+			}
+			if len(history) != 2 || history[1].Value != 0.62 {
+				t.Errorf("history = %v", history)
+			}
+
+			o.setTestTransport(getRoundTripFunc(tt.wantPath, http.StatusOK, []*EconomicValue{}))
+			if _, err := tt.latest(o); err == nil {
+				t.Errorf("latest expected error for empty time series")
+			}
+			o.setTestTransport(getRoundTripFunc(tt.wantPath, http.StatusBadRequest, nil))
+			if _, err := tt.history(o); err == nil {
+				t.Errorf("history expected error")
+			}
+		})
+	}
+}
+
+func TestClient_TOPS(t *testing.T) {
+	var d []*TOPS
+	getTestData(`[{"symbol":"SNAP","sector":"softwareservices","securityType":"commonstock","bidPrice":10.5,"bidSize":100,"askPrice":10.6,"askSize":200,"lastUpdated":1494538496261,"lastSalePrice":10.55,"lastSaleSize":100,"lastSaleTime":1494538496261,"volume":1000}]`, &d)
+	type args struct {
+		symbols []string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*TOPS
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/tops", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/tops", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.TOPS(tt.args.symbols...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.TOPS() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.TOPS() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_TOPSLast(t *testing.T) {
+	var d []*Last
+	getTestData(`[{"symbol":"SNAP","price":10.55,"size":100,"time":1494538496261}]`, &d)
+	type args struct {
+		symbols []string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      []*Last
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/tops/last", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/tops/last", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.TOPSLast(tt.args.symbols...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.TOPSLast() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.TOPSLast() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Deep(t *testing.T) {
+	var d map[string]*DEEP
+	getTestData(`{"SNAP":{"symbol":"SNAP","marketPercent":0.008,"volume":1000,"bids":[{"price":10.5,"size":100,"timestamp":1494538496261}],"systemEvent":{"systemEvent":"R","timestamp":1494538496261},"tradingStatus":{"status":"T","reason":"","timestamp":1494538496261}},"AAPL":{"symbol":"AAPL","volume":2000}}`, &d)
+	type args struct {
+		symbols []string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      map[string]*DEEP
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/deep", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/deep", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.Deep(tt.args.symbols...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.Deep() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Deep() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DeepBook(t *testing.T) {
+	var d map[string]*DeepBook
+	getTestData(`{"SNAP":{"bids":[{"price":10.5,"size":100,"timestamp":1494538496261}],"asks":[{"price":10.6,"size":200,"timestamp":1494538496261}]}}`, &d)
+	type args struct {
+		symbols []string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      map[string]*DeepBook
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/deep/book", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/deep/book", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.DeepBook(tt.args.symbols...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.DeepBook() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.DeepBook() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DeepTrades(t *testing.T) {
+	var d map[string][]Trade
+	getTestData(`{"SNAP":[{"price":10.55,"size":100,"tradeId":517341294,"isISO":false,"isOddLot":false,"isOutsideRegularHours":false,"isSinglePriceCross":false,"isTradeThroughExempt":false,"timestamp":1494619192003}]}`, &d)
+	type args struct {
+		symbols []string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      map[string][]Trade
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/deep/trades", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/deep/trades", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.DeepTrades(tt.args.symbols...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.DeepTrades() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.DeepTrades() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DeepTradingStatus(t *testing.T) {
+	var d map[string]*TradingStatus
+	getTestData(`{"SNAP":{"status":"H","reason":"T1","timestamp":1494588017674}}`, &d)
+	type args struct {
+		symbols []string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      map[string]*TradingStatus
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/deep/trading-status", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/deep/trading-status", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.DeepTradingStatus(tt.args.symbols...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.DeepTradingStatus() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.DeepTradingStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DeepOpHaltStatus(t *testing.T) {
+	var d map[string]*OpHaltStatus
+	getTestData(`{"SNAP":{"isHalted":false,"timestamp":1494588017674}}`, &d)
+	type args struct {
+		symbols []string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      map[string]*OpHaltStatus
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/deep/op-halt-status", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/deep/op-halt-status", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.DeepOpHaltStatus(tt.args.symbols...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.DeepOpHaltStatus() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.DeepOpHaltStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DeepSSRStatus(t *testing.T) {
+	var d map[string]*SSRStatus
+	getTestData(`{"SNAP":{"isSSR":true,"detail":"N","timestamp":1494588094067}}`, &d)
+	type args struct {
+		symbols []string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      map[string]*SSRStatus
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/deep/ssr-status", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/deep/ssr-status", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.DeepSSRStatus(tt.args.symbols...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.DeepSSRStatus() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.DeepSSRStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DeepSecurityEvent(t *testing.T) {
+	var d map[string]*SecurityEvent
+	getTestData(`{"SNAP":{"securityEvent":"MarketOpen","timestamp":1494595800005}}`, &d)
+	type args struct {
+		symbols []string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      map[string]*SecurityEvent
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/deep/security-event", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/deep/security-event", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.DeepSecurityEvent(tt.args.symbols...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.DeepSecurityEvent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.DeepSecurityEvent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DeepTradeBreaks(t *testing.T) {
+	var d map[string][]Trade
+	getTestData(`{"SNAP":[{"price":10.55,"size":100,"tradeId":517341294,"timestamp":1494619192003}]}`, &d)
+	type args struct {
+		symbols []string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      map[string][]Trade
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/deep/trade-breaks", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/deep/trade-breaks", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.DeepTradeBreaks(tt.args.symbols...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.DeepTradeBreaks() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.DeepTradeBreaks() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DeepAuction(t *testing.T) {
+	var d map[string]*Auction
+	getTestData(`{"SNAP":{"auctionType":"Open","pairedShares":3600,"imbalanceShares":600,"referencePrice":1.05,"indicativePrice":1.05,"auctionBookPrice":1.05,"collarReferencePrice":1.05,"lowerCollarPrice":0.35,"upperCollarPrice":1.75,"extensionNumber":0,"startTime":1494595800000,"lastUpdate":1494595800005}}`, &d)
+	type args struct {
+		symbols []string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      map[string]*Auction
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/deep/auction", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/deep/auction", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.DeepAuction(tt.args.symbols...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.DeepAuction() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.DeepAuction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DeepOfficialPrice(t *testing.T) {
+	var d map[string]*OfficialPrice
+	getTestData(`{"SNAP":{"priceType":"Open","price":1.05,"timestamp":1494595800005}}`, &d)
+	type args struct {
+		symbols []string
+	}
+	tests := []struct {
+		name      string
+		o         *Client
+		args      args
+		want      map[string]*OfficialPrice
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      d,
+			roundTrip: getRoundTripFunc("/deep/official-price", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name: "Request Failed",
+			o:    NewClient("", WithSandbox()),
+			args: args{
+				symbols: []string{"SNAP", "AAPL"},
+			},
+			want:      nil,
+			roundTrip: getRoundTripFunc("/deep/official-price", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.DeepOfficialPrice(tt.args.symbols...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.DeepOfficialPrice() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.DeepOfficialPrice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DeepSystemEvent(t *testing.T) {
+	d := &SystemEvent{}
+	getTestData(`{"systemEvent":"R","timestamp":1494627280000}`, &d)
+	tests := []struct {
+		name      string
+		o         *Client
+		want      *SystemEvent
+		roundTrip roundTripFunc
+		wantErr   bool
+	}{
+		{
+			name:      "Success",
+			o:         NewClient("", WithSandbox()),
+			want:      d,
+			roundTrip: getRoundTripFunc("/deep/system-event", http.StatusOK, d),
+			wantErr:   false,
+		},
+		{
+			name: "Failed to create request",
+			o: &Client{
+				baseURL: "://",
+			},
+			want:      nil,
+			roundTrip: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "Request Failed",
+			o:         NewClient("", WithSandbox()),
+			want:      nil,
+			roundTrip: getRoundTripFunc("/deep/system-event", http.StatusBadRequest, APIError{StatusCode: http.StatusBadRequest}),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		if tt.roundTrip != nil {
+			tt.o.setTestTransport(tt.roundTrip)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.DeepSystemEvent()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.DeepSystemEvent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.DeepSystemEvent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DeepSingleSymbol(t *testing.T) {
+	o := NewClient("", WithSandbox())
+	o.setTestTransport(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(`{"symbol":"SNAP","volume":1000,"systemEvent":{"systemEvent":"R","timestamp":1494627280000}}`)),
+			Header:     make(http.Header),
+		}
+	})
+	got, err := o.Deep("SNAP")
+	if err != nil {
+		t.Fatalf("Client.Deep() error = %v", err)
+	}
+	if len(got) != 1 || got["SNAP"] == nil || got["SNAP"].Volume != 1000 || got["SNAP"].SystemEvent.SystemEvent != systemevent.StartOfRegularMarketHours {
+		t.Errorf("Client.Deep() = %v", got)
+	}
+}
@@ -0,0 +1,51 @@
+package iex
+
+//CryptoQuote https://iexcloud.io/docs/api/#cryptocurrency-quote
+type CryptoQuote struct {
+	Symbol           string    `json:"symbol"`
+	PrimaryExchange  string    `json:"primaryExchange"`
+	Sector           string    `json:"sector"`
+	CalculationPrice string    `json:"calculationPrice"`
+	LatestPrice      float64   `json:"latestPrice,string"`
+	LatestSource     string    `json:"latestSource"`
+	LatestUpdate     EpochTime `json:"latestUpdate"`
+	LatestVolume     float64   `json:"latestVolume,string"`
+	BidPrice         float64   `json:"bidPrice,string"`
+	BidSize          float64   `json:"bidSize,string"`
+	AskPrice         float64   `json:"askPrice,string"`
+	AskSize          float64   `json:"askSize,string"`
+	High             float64   `json:"high,string"`
+	Low              float64   `json:"low,string"`
+	PreviousClose    float64   `json:"previousClose,string"`
+}
+
+//CryptoPrice https://iexcloud.io/docs/api/#cryptocurrency-price
+type CryptoPrice struct {
+	Symbol string  `json:"symbol"`
+	Price  float64 `json:"price,string"`
+}
+
+//CryptoBook https://iexcloud.io/docs/api/#cryptocurrency-book
+type CryptoBook struct {
+	Symbol string         `json:"symbol"`
+	Bids   []CryptoBidAsk `json:"bids"`
+	Asks   []CryptoBidAsk `json:"asks"`
+}
+
+//CryptoBidAsk price level of a CryptoBook. Unlike BidAsk, sizes are fractional.
+type CryptoBidAsk struct {
+	Price     float64   `json:"price,string"`
+	Size      float64   `json:"size,string"`
+	Timestamp EpochTime `json:"timestamp"`
+}
+
+//CryptoEvent order book event from the cryptoEvents stream https://iexcloud.io/docs/api/#cryptocurrency-events
+type CryptoEvent struct {
+	Symbol    string    `json:"symbol"`
+	EventType string    `json:"eventType"`
+	Timestamp EpochTime `json:"timestamp"`
+	Reason    string    `json:"reason"`
+	Price     float64   `json:"price,string"`
+	Size      float64   `json:"size,string"`
+	Side      string    `json:"side"`
+}
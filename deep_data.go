@@ -0,0 +1,183 @@
+package iex
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+//TOPS https://iexcloud.io/docs/api/#tops
+type TOPS struct {
+	Symbol        string    `json:"symbol"`
+	Sector        string    `json:"sector"`
+	SecurityType  string    `json:"securityType"`
+	BidPrice      float64   `json:"bidPrice"`
+	BidSize       int       `json:"bidSize"`
+	AskPrice      float64   `json:"askPrice"`
+	AskSize       int       `json:"askSize"`
+	LastUpdated   EpochTime `json:"lastUpdated"`
+	LastSalePrice float64   `json:"lastSalePrice"`
+	LastSaleSize  int       `json:"lastSaleSize"`
+	LastSaleTime  EpochTime `json:"lastSaleTime"`
+	Volume        int       `json:"volume"`
+}
+
+//Last https://iexcloud.io/docs/api/#last
+type Last struct {
+	Symbol string    `json:"symbol"`
+	Price  float64   `json:"price"`
+	Size   int       `json:"size"`
+	Time   EpochTime `json:"time"`
+}
+
+//DEEP https://iexcloud.io/docs/api/#deep
+type DEEP struct {
+	Symbol        string        `json:"symbol"`
+	MarketPercent float64       `json:"marketPercent"`
+	Volume        int           `json:"volume"`
+	LastSalePrice float64       `json:"lastSalePrice"`
+	LastSaleSize  int           `json:"lastSaleSize"`
+	LastSaleTime  EpochTime     `json:"lastSaleTime"`
+	LastUpdated   EpochTime     `json:"lastUpdated"`
+	Bids          []BidAsk      `json:"bids"`
+	Asks          []BidAsk      `json:"asks"`
+	SystemEvent   SystemEvent   `json:"systemEvent"`
+	TradingStatus TradingStatus `json:"tradingStatus"`
+	OpHaltStatus  OpHaltStatus  `json:"opHaltStatus"`
+	SSRStatus     SSRStatus     `json:"ssrStatus"`
+	SecurityEvent SecurityEvent `json:"securityEvent"`
+	Trades        []Trade       `json:"trades"`
+	TradeBreaks   []Trade       `json:"tradeBreaks"`
+	Auction       Auction       `json:"auction"`
+}
+
+//DeepBook https://iexcloud.io/docs/api/#deep-book
+type DeepBook struct {
+	Bids []BidAsk `json:"bids"`
+	Asks []BidAsk `json:"asks"`
+}
+
+//TradingStatus https://iexcloud.io/docs/api/#deep-trading-status
+type TradingStatus struct {
+	Status    string    `json:"status"`
+	Reason    string    `json:"reason"`
+	Timestamp EpochTime `json:"timestamp"`
+}
+
+//OpHaltStatus https://iexcloud.io/docs/api/#deep-operational-halt-status
+type OpHaltStatus struct {
+	IsHalted  bool      `json:"isHalted"`
+	Timestamp EpochTime `json:"timestamp"`
+}
+
+//SSRStatus https://iexcloud.io/docs/api/#deep-short-sale-price-test-status
+type SSRStatus struct {
+	IsSSR     bool      `json:"isSSR"`
+	Detail    string    `json:"detail"`
+	Timestamp EpochTime `json:"timestamp"`
+}
+
+//SecurityEvent https://iexcloud.io/docs/api/#deep-security-event
+type SecurityEvent struct {
+	SecurityEvent string    `json:"securityEvent"`
+	Timestamp     EpochTime `json:"timestamp"`
+}
+
+//Auction https://iexcloud.io/docs/api/#deep-auction
+type Auction struct {
+	AuctionType          string    `json:"auctionType"`
+	PairedShares         int       `json:"pairedShares"`
+	ImbalanceShares      int       `json:"imbalanceShares"`
+	ReferencePrice       float64   `json:"referencePrice"`
+	IndicativePrice      float64   `json:"indicativePrice"`
+	AuctionBookPrice     float64   `json:"auctionBookPrice"`
+	CollarReferencePrice float64   `json:"collarReferencePrice"`
+	LowerCollarPrice     float64   `json:"lowerCollarPrice"`
+	UpperCollarPrice     float64   `json:"upperCollarPrice"`
+	ExtensionNumber      int       `json:"extensionNumber"`
+	StartTime            EpochTime `json:"startTime"`
+	LastUpdate           EpochTime `json:"lastUpdate"`
+}
+
+//OfficialPrice https://iexcloud.io/docs/api/#deep-official-price
+type OfficialPrice struct {
+	PriceType string    `json:"priceType"`
+	Price     float64   `json:"price"`
+	Timestamp EpochTime `json:"timestamp"`
+}
+
+//DeepEvent message from a DEEP stream https://iexcloud.io/docs/api/#deep
+//Only the field matching MessageType is set. Data always holds the raw payload.
+type DeepEvent struct {
+	Symbol        string          `json:"symbol"`
+	MessageType   string          `json:"messageType"`
+	Seq           int64           `json:"seq"`
+	Data          json.RawMessage `json:"data"`
+	Deep          *DEEP           `json:"-"`
+	Book          *DeepBook       `json:"-"`
+	Trades        []Trade         `json:"-"`
+	TradeBreaks   []Trade         `json:"-"`
+	SystemEvent   *SystemEvent    `json:"-"`
+	TradingStatus *TradingStatus  `json:"-"`
+	OpHaltStatus  *OpHaltStatus   `json:"-"`
+	SSRStatus     *SSRStatus      `json:"-"`
+	SecurityEvent *SecurityEvent  `json:"-"`
+	Auction       *Auction        `json:"-"`
+}
+
+//decodeData decode Data into the field matching MessageType
+func (e *DeepEvent) decodeData() error {
+	if len(e.Data) == 0 || string(e.Data) == "null" {
+		return nil
+	}
+	//Message types are documented in different spellings, e.g. opHaltStatus and op-halt-status
+	switch strings.NewReplacer("-", "", "_", "").Replace(strings.ToLower(e.MessageType)) {
+	case "deep":
+		e.Deep = &DEEP{}
+		return json.Unmarshal(e.Data, e.Deep)
+	case "book":
+		e.Book = &DeepBook{}
+		return json.Unmarshal(e.Data, e.Book)
+	case "trades", "trade":
+		return decodeEvent(e.Data, &e.Trades)
+	case "tradebreaks", "tradebreak":
+		return decodeEvent(e.Data, &e.TradeBreaks)
+	case "systemevent":
+		e.SystemEvent = &SystemEvent{}
+		return json.Unmarshal(e.Data, e.SystemEvent)
+	case "tradingstatus":
+		e.TradingStatus = &TradingStatus{}
+		return json.Unmarshal(e.Data, e.TradingStatus)
+	case "ophaltstatus":
+		e.OpHaltStatus = &OpHaltStatus{}
+		return json.Unmarshal(e.Data, e.OpHaltStatus)
+	case "ssr", "ssrstatus":
+		e.SSRStatus = &SSRStatus{}
+		return json.Unmarshal(e.Data, e.SSRStatus)
+	case "securityevent":
+		e.SecurityEvent = &SecurityEvent{}
+		return json.Unmarshal(e.Data, e.SecurityEvent)
+	case "auction":
+		e.Auction = &Auction{}
+		return json.Unmarshal(e.Data, e.Auction)
+	}
+	return nil
+}
+
+//decodeDeep decode a DEEP response, which is a single object for one symbol and keyed by symbol otherwise
+func decodeDeep(data []byte) (map[string]*DEEP, error) {
+	var probe struct {
+		Symbol string `json:"symbol"`
+	}
+	if err := json.Unmarshal(data, &probe); err == nil && probe.Symbol != "" {
+		deep := &DEEP{}
+		if err := json.Unmarshal(data, deep); err != nil {
+			return nil, err
+		}
+		return map[string]*DEEP{deep.Symbol: deep}, nil
+	}
+	ret := map[string]*DEEP{}
+	if err := json.Unmarshal(data, &ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,9 @@
+package iex
+
+//EconomicValue treasury rate, commodity price or economic indicator as of Date
+//https://iexcloud.io/docs/api/#treasury-rates, https://iexcloud.io/docs/api/#commodities and https://iexcloud.io/docs/api/#economic-data
+type EconomicValue struct {
+	Key   string    `json:"key"`
+	Value float64   `json:"value"`
+	Date  EpochTime `json:"date"`
+}
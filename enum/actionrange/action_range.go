@@ -0,0 +1,14 @@
+package actionrange
+
+//Corporate action range options for https://iexcloud.io/docs/api/#dividends-basic and https://iexcloud.io/docs/api/#splits-basic
+const (
+	NoRange     string = ""
+	FiveYears   string = "5y"
+	TwoYears    string = "2y"
+	OneYear     string = "1y"
+	YTD         string = "ytd"
+	SixMonths   string = "6m"
+	ThreeMonths string = "3m"
+	OneMonth    string = "1m"
+	Next        string = "next"
+)
@@ -0,0 +1,23 @@
+package batchtype
+
+//Batch types for https://iexcloud.io/docs/api/#batch-requests
+const (
+	AdvancedStats  string = "advanced-stats"
+	Book           string = "book"
+	Chart          string = "chart"
+	Company        string = "company"
+	DelayedQuote   string = "delayed-quote"
+	Dividends      string = "dividends"
+	IntradayPrices string = "intraday-prices"
+	LargestTrades  string = "largest-trades"
+	Logo           string = "logo"
+	News           string = "news"
+	OHLC           string = "ohlc"
+	Peers          string = "peers"
+	Previous       string = "previous"
+	Price          string = "price"
+	Quote          string = "quote"
+	Splits         string = "splits"
+	Stats          string = "stats"
+	VolumeByVenue  string = "volume-by-venue"
+)
@@ -0,0 +1,15 @@
+package commodity
+
+//Commodity prices for https://iexcloud.io/docs/api/#commodities
+const (
+	WTI              string = "DCOILWTICO"
+	Brent            string = "DCOILBRENTEU"
+	NaturalGas       string = "DHHNGSP"
+	HeatingOil       string = "DHOILNYH"
+	JetFuel          string = "DJFUELUSGULF"
+	Diesel           string = "GASDESW"
+	GasolineRegular  string = "GASREGCOVW"
+	GasolineMidgrade string = "GASMIDCOVW"
+	GasolinePremium  string = "GASPRMCOVW"
+	Propane          string = "DPROPANEMBTX"
+)
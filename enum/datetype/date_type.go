@@ -0,0 +1,7 @@
+package datetype
+
+//Date types for https://iexcloud.io/docs/api/#u-s-holidays-and-trading-dates
+const (
+	Trade   string = "trade"
+	Holiday string = "holiday"
+)
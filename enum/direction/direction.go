@@ -0,0 +1,7 @@
+package direction
+
+//Directions for https://iexcloud.io/docs/api/#u-s-holidays-and-trading-dates
+const (
+	Next string = "next"
+	Last string = "last"
+)
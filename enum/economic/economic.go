@@ -0,0 +1,15 @@
+package economic
+
+//Economic indicators for https://iexcloud.io/docs/api/#economic-data
+const (
+	CPI                  string = "CPIAUCSL"
+	FederalFunds         string = "FEDFUNDS"
+	RealGDP              string = "A191RL1Q225SBEA"
+	Unemployment         string = "UNRATE"
+	InitialClaims        string = "IC4WSA"
+	IndustrialProduction string = "INDPRO"
+	Mortgage30Year       string = "MORTGAGE30US"
+	Mortgage15Year       string = "MORTGAGE15US"
+	Mortgage5Year        string = "MORTGAGE5US"
+	RecessionProbability string = "RECPROUSM156N"
+)
@@ -0,0 +1,10 @@
+package listtype
+
+//List types for https://iexcloud.io/docs/api/#list
+const (
+	MostActive string = "mostactive"
+	Gainers    string = "gainers"
+	Losers     string = "losers"
+	IEXVolume  string = "iexvolume"
+	IEXPercent string = "iexpercent"
+)
@@ -0,0 +1,7 @@
+package optionside
+
+//Option sides for https://iexcloud.io/docs/api/#options
+const (
+	Call string = "call"
+	Put  string = "put"
+)
@@ -0,0 +1,7 @@
+package period
+
+//Report periods for https://iexcloud.io/docs/api/#income-statement and the other financial reports
+const (
+	Annual  string = "annual"
+	Quarter string = "quarter"
+)
@@ -0,0 +1,26 @@
+package ssechannel
+
+//Stock quote channels for https://iexcloud.io/docs/api/#sse-streaming
+const (
+	StocksUS        string = "stocksUS"
+	StocksUSNoUTP   string = "stocksUSNoUTP"
+	StocksUS1Second string = "stocksUS1Second"
+	StocksUS5Second string = "stocksUS5Second"
+	StocksUS1Minute string = "stocksUS1Minute"
+)
+
+//Exchange channels for https://iexcloud.io/docs/api/#deep
+const (
+	Deep          string = "deep"
+	Book          string = "book"
+	Trades        string = "trades"
+	SystemEvent   string = "system-event"
+	TradingStatus string = "tradingstatus"
+	Auction       string = "auction"
+	OpHaltStatus  string = "op-halt-status"
+	SSRStatus     string = "ssr-status"
+	SecurityEvent string = "security-event"
+	TradeBreaks   string = "trade-breaks"
+	TOPS          string = "tops"
+	Last          string = "last"
+)
@@ -0,0 +1,11 @@
+package systemevent
+
+//System event codes for https://iexcloud.io/docs/api/#deep-system-event
+const (
+	StartOfMessages           string = "O"
+	StartOfSystemHours        string = "S"
+	StartOfRegularMarketHours string = "R"
+	EndOfRegularMarketHours   string = "M"
+	EndOfSystemHours          string = "E"
+	EndOfMessages             string = "C"
+)
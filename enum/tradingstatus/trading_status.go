@@ -0,0 +1,23 @@
+package tradingstatus
+
+//Trading status codes for https://iexcloud.io/docs/api/#deep-trading-status
+const (
+	Halted                      string = "H"
+	ReleasedIntoOrderAcceptance string = "O"
+	Paused                      string = "P"
+	Trading                     string = "T"
+)
+
+//Trading halt and pause reasons for https://iexcloud.io/docs/api/#deep-trading-status
+const (
+	HaltNewsPending            string = "T1"
+	IPOIssueNotYetTrading      string = "IPO1"
+	IPOIssueDeferred           string = "IPOD"
+	MarketCircuitBreakerLevel3 string = "MCB3"
+	ReasonNotAvailable         string = "NA"
+	HaltNewsDissemination      string = "T2"
+	IPONewIssueOrderAcceptance string = "IPO2"
+	IPOPreLaunchPeriod         string = "IPO3"
+	MarketCircuitBreakerLevel1 string = "MCB1"
+	MarketCircuitBreakerLevel2 string = "MCB2"
+)
@@ -0,0 +1,15 @@
+package treasury
+
+//Treasury constant maturity rate tenors for https://iexcloud.io/docs/api/#treasury-rates
+const (
+	Rate1Month string = "DGS1MO"
+	Rate3Month string = "DGS3MO"
+	Rate6Month string = "DGS6MO"
+	Rate1Year  string = "DGS1"
+	Rate2Year  string = "DGS2"
+	Rate5Year  string = "DGS5"
+	Rate7Year  string = "DGS7"
+	Rate10Year string = "DGS10"
+	Rate20Year string = "DGS20"
+	Rate30Year string = "DGS30"
+)
@@ -9,9 +9,47 @@ import (
 type APIError struct {
 	StatusCode int
 	Message    string
+	//Attempts number of requests sent before giving up
+	Attempts int
 }
 
 //Error implements the error interface
 func (e APIError) Error() string {
+	if e.Attempts > 1 {
+		return fmt.Sprintf("%d %s after %d attempts: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Attempts, e.Message)
+	}
 	return fmt.Sprintf("%d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
 }
+
+//RequestError network error of the last attempt to send a request
+type RequestError struct {
+	Err error
+	//Attempts number of requests sent before giving up
+	Attempts int
+}
+
+//Error implements the error interface
+func (e RequestError) Error() string {
+	if e.Attempts > 1 {
+		return fmt.Sprintf("after %d attempts: %v", e.Attempts, e.Err)
+	}
+	return e.Err.Error()
+}
+
+//Unwrap returns the underlying network error
+func (e RequestError) Unwrap() error {
+	return e.Err
+}
+
+//BudgetExceededError returned instead of sending a request that would exceed the message budget set by WithMessageBudget
+type BudgetExceededError struct {
+	Endpoint string
+	Budget   int64
+	Used     int64
+	Weight   int64
+}
+
+//Error implements the error interface
+func (e BudgetExceededError) Error() string {
+	return fmt.Sprintf("message budget exceeded: %s needs %d messages, %d of %d used", e.Endpoint, e.Weight, e.Used, e.Budget)
+}
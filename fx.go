@@ -0,0 +1,97 @@
+package iex
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+//defaultQuoteCurrency currency of quotes without one
+const defaultQuoteCurrency = "USD"
+
+//Converter convert amounts between currencies with the latest fetched rates, e.g. from Client.FXLatest.
+//Inverse rates are used when only the opposite pair is known. It is safe for concurrent use.
+type Converter struct {
+	mu    sync.RWMutex
+	rates map[CurrencyPair]float64
+}
+
+//NewConverter create a converter from rates
+func NewConverter(rates []*ExchangeRate) (*Converter, error) {
+	c := &Converter{rates: make(map[CurrencyPair]float64)}
+	if err := c.Update(rates); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+//Update replace the rates of the given pairs, keeping the others
+func (c *Converter) Update(rates []*ExchangeRate) error {
+	parsed := make(map[CurrencyPair]float64, len(rates))
+	for _, r := range rates {
+		pair, err := ParseCurrencyPair(r.Symbol)
+		if err != nil {
+			return err
+		}
+		if r.Rate <= 0 {
+			return fmt.Errorf("invalid rate %v for %s", r.Rate, pair)
+		}
+		parsed[pair] = r.Rate
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for pair, rate := range parsed {
+		c.rates[pair] = rate
+	}
+	return nil
+}
+
+//Rate units of to per unit of from
+func (c *Converter) Rate(from, to string) (float64, error) {
+	pair := CurrencyPair{From: strings.ToUpper(from), To: strings.ToUpper(to)}
+	if pair.From == pair.To {
+		return 1, nil
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if rate, ok := c.rates[pair]; ok {
+		return rate, nil
+	}
+	if rate, ok := c.rates[pair.Inverse()]; ok {
+		return 1 / rate, nil
+	}
+	return 0, fmt.Errorf("no exchange rate for %s", pair)
+}
+
+//Convert amount in from to to
+func (c *Converter) Convert(amount float64, from, to string) (float64, error) {
+	rate, err := c.Rate(from, to)
+	if err != nil {
+		return 0, err
+	}
+	return amount * rate, nil
+}
+
+//ConvertQuote the quote's LatestPrice in currency. Quotes without a currency are taken to be in USD.
+func (c *Converter) ConvertQuote(quote *Quote, currency string) (float64, error) {
+	if quote == nil {
+		return 0, fmt.Errorf("no quote to convert to %s", currency)
+	}
+	from := quote.Currency
+	if from == "" {
+		from = defaultQuoteCurrency
+	}
+	return c.Convert(quote.LatestPrice, from, currency)
+}
+
+//pairSymbols symbols parameter of the fx endpoints, which reject requests without pairs
+func pairSymbols(pairs []CurrencyPair) (string, error) {
+	if len(pairs) == 0 {
+		return "", fmt.Errorf("at least one currency pair is required")
+	}
+	symbols := make([]string, len(pairs))
+	for i, p := range pairs {
+		symbols[i] = p.String()
+	}
+	return strings.Join(symbols, ","), nil
+}
@@ -0,0 +1,62 @@
+package iex
+
+import (
+	"fmt"
+	"strings"
+)
+
+//CurrencyPair currency pair such as EURUSD, quoted as units of To per unit of From
+type CurrencyPair struct {
+	From string `json:"fromCurrency"`
+	To   string `json:"toCurrency"`
+}
+
+//ParseCurrencyPair parse a six letter pair symbol such as "EURUSD"
+func ParseCurrencyPair(symbol string) (CurrencyPair, error) {
+	if len(symbol) != 6 {
+		return CurrencyPair{}, fmt.Errorf("invalid currency pair %q", symbol)
+	}
+	symbol = strings.ToUpper(symbol)
+	return CurrencyPair{From: symbol[:3], To: symbol[3:]}, nil
+}
+
+//String the pair symbol, e.g. EURUSD
+func (p CurrencyPair) String() string {
+	return p.From + p.To
+}
+
+//Inverse the pair with From and To swapped
+func (p CurrencyPair) Inverse() CurrencyPair {
+	return CurrencyPair{From: p.To, To: p.From}
+}
+
+//ExchangeRate https://iexcloud.io/docs/api/#latest-currency-rates
+type ExchangeRate struct {
+	Symbol    string    `json:"symbol"`
+	Rate      float64   `json:"rate"`
+	Timestamp EpochTime `json:"timestamp"`
+}
+
+//ConvertedAmount https://iexcloud.io/docs/api/#currency-conversion
+type ConvertedAmount struct {
+	ExchangeRate
+	Amount float64 `json:"amount"`
+}
+
+//HistoricalRate https://iexcloud.io/docs/api/#historical-daily
+type HistoricalRate struct {
+	ExchangeRate
+	Date string `json:"date"`
+}
+
+//Currency https://iexcloud.io/docs/api/#fx-symbols
+type Currency struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+//FXSymbols https://iexcloud.io/docs/api/#fx-symbols
+type FXSymbols struct {
+	Currencies []*Currency     `json:"currencies"`
+	Pairs      []*CurrencyPair `json:"pairs"`
+}
@@ -33,6 +33,79 @@ type IntradayOption struct {
 	ChartIEXWhenNull bool
 }
 
+//ListOption for https://iexcloud.io/docs/api/#list
+type ListOption struct {
+	DisplayPercent bool
+	ListLimit      int
+}
+
+//USDateOption for https://iexcloud.io/docs/api/#u-s-holidays-and-trading-dates
+type USDateOption struct {
+	Type      string
+	Direction string
+	Last      int
+	StartDate string
+}
+
+//TimeSeriesOption for https://iexcloud.io/docs/api/#time-series
+//Key and SubKey are optional. Dates are formatted as YYYY-MM-DD.
+type TimeSeriesOption struct {
+	ID       string
+	Key      string
+	SubKey   string
+	Range    string
+	From     string
+	To       string
+	On       string
+	Last     int
+	First    int
+	Calendar bool
+	Limit    int
+	//Subattribute filter on other attributes, e.g. "source|WSJ" or "source|WSJ,type|Oil"
+	Subattribute string
+	DateField    string
+}
+
+//FinancialOption for https://iexcloud.io/docs/api/#income-statement, balance sheet, cash flow and financials
+type FinancialOption struct {
+	Symbol string
+	Period string
+	Last   int
+}
+
+//BatchOption for https://iexcloud.io/docs/api/#batch-requests
+//Symbol in Chart and Intraday is ignored. Parameters they share, such as ChartInterval, are sent once with the Intraday value taking precedence.
+type BatchOption struct {
+	Symbols        []string
+	Types          []string
+	Chart          *HistoricalOption
+	Intraday       *IntradayOption
+	DisplayPercent bool
+}
+
+//BatchResult data for one symbol of https://iexcloud.io/docs/api/#batch-requests
+//Only the fields of the requested types are set.
+type BatchResult struct {
+	AdvancedStats  *AdvancedStats     `json:"advanced-stats"`
+	Book           *Book              `json:"book"`
+	Chart          []*HistoricalPrice `json:"chart"`
+	Company        *Company           `json:"company"`
+	DelayedQuote   *DelayedQuote      `json:"delayed-quote"`
+	Dividends      []*Dividend        `json:"dividends"`
+	IntradayPrices []*IntradayPrice   `json:"intraday-prices"`
+	LargestTrades  []*LargestTrade    `json:"largest-trades"`
+	Logo           *Logo              `json:"logo"`
+	News           []*NewsItem        `json:"news"`
+	OHLC           *OHLC              `json:"ohlc"`
+	Peers          []string           `json:"peers"`
+	Previous       *PreviousDayPrice  `json:"previous"`
+	Price          *float64           `json:"price"`
+	Quote          *Quote             `json:"quote"`
+	Splits         []*Split           `json:"splits"`
+	Stats          *KeyStats          `json:"stats"`
+	VolumeByVenue  []*VolumeByVenue   `json:"volume-by-venue"`
+}
+
 //SystemEvent models a system event for a quote.
 type SystemEvent struct {
 	SystemEvent string    `json:"systemEvent"`
@@ -0,0 +1,22 @@
+package iex
+
+//SectorPerformance https://iexcloud.io/docs/api/#sector-performance
+type SectorPerformance struct {
+	Type        string    `json:"type"`
+	Name        string    `json:"name"`
+	Performance float64   `json:"performance"`
+	LastUpdated EpochTime `json:"lastUpdated"`
+}
+
+//MarketVolume https://iexcloud.io/docs/api/#u-s-exchanges
+type MarketVolume struct {
+	MIC           string    `json:"mic"`
+	TapeID        string    `json:"tapeId"`
+	VenueName     string    `json:"venueName"`
+	Volume        int64     `json:"volume"`
+	TapeA         int64     `json:"tapeA"`
+	TapeB         int64     `json:"tapeB"`
+	TapeC         int64     `json:"tapeC"`
+	MarketPercent float64   `json:"marketPercent"`
+	LastUpdated   EpochTime `json:"lastUpdated"`
+}
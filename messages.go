@@ -0,0 +1,211 @@
+package iex
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const messagesUsedHeader = "iexcloud-messages-used"
+
+//defaultMessageWeight messages charged for endpoints missing from messageWeights
+const defaultMessageWeight int64 = 1
+
+//messageWeights estimated messages per call by endpoint key.
+//Only used when a response has no iexcloud-messages-used header and to check the budget before a call.
+//https://iexcloud.io/docs/api/#data-weighting
+var messageWeights = map[string]int64{
+	"account/metadata":              0,
+	"crypto/book":                   1,
+	"crypto/price":                  1,
+	"crypto/quote":                  1,
+	"fx/convert":                    500,
+	"fx/historical":                 500,
+	"fx/latest":                     500,
+	"market":                        1,
+	"ref-data/crypto":               100,
+	"ref-data/fx":                   100,
+	"ref-data/iex":                  100,
+	"ref-data/mutual-funds":         100,
+	"ref-data/otc":                  100,
+	"ref-data/region":               100,
+	"ref-data/symbols":              100,
+	"stock/advanced-stats":          3005,
+	"stock/balance-sheet":           3000,
+	"stock/book":                    1,
+	"stock/cash-flow":               1000,
+	"stock/chart":                   10,
+	"stock/company":                 1,
+	"stock/delayed-quote":           1,
+	"stock/dividends":               10,
+	"stock/earnings":                1000,
+	"stock/estimates":               10000,
+	"stock/financials":              5000,
+	"stock/fund-ownership":          10000,
+	"stock/income":                  1000,
+	"stock/insider-roster":          5000,
+	"stock/insider-summary":         5000,
+	"stock/insider-transactions":    50,
+	"stock/institutional-ownership": 10000,
+	"stock/intraday-prices":         1,
+	"stock/largest-trades":          1,
+	"stock/list":                    10,
+	"stock/logo":                    1,
+	"stock/news":                    10,
+	"stock/ohlc":                    2,
+	"stock/options":                 1000,
+	"stock/peers":                   500,
+	"stock/previous":                2,
+	"stock/price":                   1,
+	"stock/price-target":            500,
+	"stock/quote":                   1,
+	"stock/recommendation-trends":   1000,
+	"stock/sector-performance":      11,
+	"stock/splits":                  10,
+	"stock/stats":                   5,
+	"stock/stats/stat":              1,
+	"stock/upcoming-dividends":      10,
+	"stock/upcoming-earnings":       1000,
+	"stock/upcoming-ipos":           100,
+	"stock/upcoming-splits":         10,
+	"stock/volume-by-venue":         20,
+}
+
+//symbolScoped path prefixes followed by a symbol, which is dropped from endpoint keys
+var symbolScoped = map[string]bool{
+	"crypto":      true,
+	"data-points": true,
+	"stock":       true,
+}
+
+//MessageUsage messages consumed by a Client
+type MessageUsage struct {
+	Total      int64
+	ByEndpoint map[string]int64
+}
+
+type messageCounter struct {
+	mu         sync.Mutex
+	total      int64
+	reserved   int64
+	byEndpoint map[string]int64
+}
+
+//WithMessageBudget refuse calls with BudgetExceededError once budget messages would be exceeded
+func WithMessageBudget(budget int64) Option {
+	return func(c *Client) {
+		c.budget = budget
+	}
+}
+
+//MessagesUsed running totals of messages consumed since the Client was created or last reset
+func (o *Client) MessagesUsed() MessageUsage {
+	o.usage.mu.Lock()
+	defer o.usage.mu.Unlock()
+	ret := MessageUsage{
+		Total:      o.usage.total,
+		ByEndpoint: make(map[string]int64, len(o.usage.byEndpoint)),
+	}
+	for k, v := range o.usage.byEndpoint {
+		ret.ByEndpoint[k] = v
+	}
+	return ret
+}
+
+//ResetMessagesUsed reset the running totals, e.g. at the start of a new billing cycle
+func (o *Client) ResetMessagesUsed() {
+	o.usage.mu.Lock()
+	defer o.usage.mu.Unlock()
+	o.usage.total = 0
+	o.usage.byEndpoint = nil
+}
+
+//requestWeight estimated messages for req to endpoint.
+//Batch requests are charged the weight of every requested type for every symbol.
+func requestWeight(endpoint string, req *http.Request) int64 {
+	if endpoint != "stock/batch" {
+		return endpointWeight(endpoint)
+	}
+	query := req.URL.Query()
+	symbols := int64(1)
+	if s := query.Get("symbols"); s != "" {
+		symbols = int64(len(strings.Split(s, ",")))
+	}
+	var weight int64
+	for _, t := range strings.Split(query.Get("types"), ",") {
+		if t != "" {
+			weight += endpointWeight("stock/" + t)
+		}
+	}
+	if weight == 0 {
+		weight = defaultMessageWeight
+	}
+	return weight * symbols
+}
+
+func endpointWeight(endpoint string) int64 {
+	if weight, ok := messageWeights[endpoint]; ok {
+		return weight
+	}
+	return defaultMessageWeight
+}
+
+//reserve holds the estimated weight of a call against the budget until it is settled
+func (c *messageCounter) reserve(endpoint string, weight, budget int64) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if budget > 0 && c.total+c.reserved+weight > budget {
+		return 0, BudgetExceededError{
+			Endpoint: endpoint,
+			Budget:   budget,
+			Used:     c.total + c.reserved,
+			Weight:   weight,
+		}
+	}
+	c.reserved += weight
+	return weight, nil
+}
+
+//settle replaces a reservation with the messages actually used
+func (c *messageCounter) settle(endpoint string, reserved, used int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reserved -= reserved
+	if used <= 0 {
+		return
+	}
+	if c.byEndpoint == nil {
+		c.byEndpoint = make(map[string]int64)
+	}
+	c.total += used
+	c.byEndpoint[endpoint] += used
+}
+
+func messagesUsed(resp *http.Response, estimate int64) int64 {
+	if v := resp.Header.Get(messagesUsedHeader); v != "" {
+		if used, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return used
+		}
+	}
+	return estimate
+}
+
+//endpointKey path relative to the base URL without symbols, e.g. stock/quote for /stable/stock/AAPL/quote.
+//A single key stat, e.g. /stable/stock/AAPL/stats/peRatio, is stock/stats/stat since it is weighted apart from the full stats.
+func (o *Client) endpointKey(path string) string {
+	if u, err := url.Parse(o.baseURL); err == nil {
+		path = strings.TrimPrefix(path, strings.TrimRight(u.Path, "/"))
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case len(segments) == 4 && segments[0] == "stock" && segments[2] == "stats":
+		return "stock/stats/stat"
+	case symbolScoped[segments[0]] && len(segments) >= 3:
+		return segments[0] + "/" + segments[2]
+	case symbolScoped[segments[0]] || len(segments) < 2:
+		return segments[0]
+	}
+	return segments[0] + "/" + segments[1]
+}
@@ -0,0 +1,35 @@
+package iex
+
+import "strings"
+
+//NewsItem https://iexcloud.io/docs/api/#news
+type NewsItem struct {
+	Datetime   EpochTime `json:"datetime"`
+	Headline   string    `json:"headline"`
+	Source     string    `json:"source"`
+	URL        string    `json:"url"`
+	Summary    string    `json:"summary"`
+	Related    string    `json:"related"`
+	Image      string    `json:"image"`
+	Lang       string    `json:"lang"`
+	HasPaywall bool      `json:"hasPaywall"`
+}
+
+//RelatedSymbols symbols in the comma separated Related field
+func (n *NewsItem) RelatedSymbols() []string {
+	var ret []string
+	for _, symbol := range strings.Split(n.Related, ",") {
+		if symbol = strings.TrimSpace(symbol); symbol != "" {
+			ret = append(ret, symbol)
+		}
+	}
+	return ret
+}
+
+//key identifies an item across reconnects of a news stream
+func (n *NewsItem) key() string {
+	if n.URL != "" {
+		return n.URL
+	}
+	return n.Datetime.String() + n.Headline
+}
@@ -0,0 +1,82 @@
+package iex
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	cloudURL      = "https://cloud.iexapis.com"
+	cloudSSEURL   = "https://cloud-sse.iexapis.com"
+	sandboxURL    = "https://sandbox.iexapis.com"
+	sandboxSSEURL = "https://sandbox-sse.iexapis.com"
+
+	defaultVersion   = "stable"
+	defaultUserAgent = "go-iex (https://github.com/Z-M-Huang/go-iex)"
+)
+
+//Option configures a Client created by NewClient
+type Option func(*Client)
+
+//WithSandbox use the IEX sandbox environment instead of the live cloud
+func WithSandbox() Option {
+	return func(c *Client) {
+		c.baseURL = sandboxURL
+		c.sseURL = sandboxSSEURL
+	}
+}
+
+//WithHTTPClient use hc to send requests instead of a default http.Client. A nil hc is ignored.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		if hc != nil {
+			c.client = hc
+		}
+	}
+}
+
+//WithBaseURL send REST requests to baseURL, e.g. a proxy or a local stand-in server.
+//The API version is appended unless WithVersion("") is used.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
+}
+
+//WithSSEURL connect streaming subscriptions to sseURL.
+//The API version is appended unless WithVersion("") is used.
+func WithSSEURL(sseURL string) Option {
+	return func(c *Client) {
+		c.sseURL = sseURL
+	}
+}
+
+//WithUserAgent send userAgent as the User-Agent header
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+//WithTimeout limit the time each request may take, including reading the response body
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+//WithVersion use API version "stable", "v1" or "latest". Defaults to "stable".
+func WithVersion(version string) Option {
+	return func(c *Client) {
+		c.version = version
+	}
+}
+
+func joinURL(base, version string) string {
+	base = strings.TrimRight(base, "/")
+	if version == "" {
+		return base
+	}
+	return base + "/" + strings.Trim(version, "/")
+}
@@ -0,0 +1,247 @@
+//Package options prices European options with the Black-Scholes model and solves implied volatility locally
+package options
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	iex "github.com/Z-M-Huang/go-iex"
+	"github.com/Z-M-Huang/go-iex/calendar"
+	"github.com/Z-M-Huang/go-iex/enum/optionside"
+)
+
+const (
+	daysPerYear = 365
+	//minVolatility and maxVolatility bracket the implied volatility search
+	minVolatility = 1e-6
+	maxVolatility = 5
+	ivTolerance   = 1e-8
+	ivIterations  = 100
+	//priceTolerance largest difference between the market price and the price at the solved volatility
+	priceTolerance = 1e-6
+)
+
+//Params Black-Scholes inputs
+type Params struct {
+	Spot   float64
+	Strike float64
+	//Expiry time to expiration in years
+	Expiry float64
+	//Rate continuously compounded risk-free rate, e.g. 0.05 for 5%
+	Rate float64
+	//Dividend continuous dividend yield
+	Dividend   float64
+	Volatility float64
+}
+
+//Greeks sensitivities of an option's price.
+//Theta is per year and Vega and Rho per unit change, divide by 365 and 100 for per day and per percentage point.
+type Greeks struct {
+	Delta float64
+	Gamma float64
+	Theta float64
+	Vega  float64
+	Rho   float64
+}
+
+func (p Params) validate() error {
+	switch {
+	case p.Spot <= 0:
+		return fmt.Errorf("spot must be positive, got %v", p.Spot)
+	case p.Strike <= 0:
+		return fmt.Errorf("strike must be positive, got %v", p.Strike)
+	case p.Expiry <= 0:
+		return fmt.Errorf("expiry must be positive, got %v", p.Expiry)
+	case p.Volatility <= 0:
+		return fmt.Errorf("volatility must be positive, got %v", p.Volatility)
+	}
+	return nil
+}
+
+func validateSide(side string) error {
+	if side != optionside.Call && side != optionside.Put {
+		return fmt.Errorf("unknown option side %q", side)
+	}
+	return nil
+}
+
+//d1 and d2 of the Black-Scholes formula
+func (p Params) d() (float64, float64) {
+	sqrtT := math.Sqrt(p.Expiry)
+	d1 := (math.Log(p.Spot/p.Strike) + (p.Rate-p.Dividend+p.Volatility*p.Volatility/2)*p.Expiry) / (p.Volatility * sqrtT)
+	return d1, d1 - p.Volatility*sqrtT
+}
+
+//Price theoretical value of a European option, side is from optionside
+func Price(side string, p Params) (float64, error) {
+	if err := validateSide(side); err != nil {
+		return 0, err
+	}
+	if err := p.validate(); err != nil {
+		return 0, err
+	}
+	return price(side, p), nil
+}
+
+func price(side string, p Params) float64 {
+	d1, d2 := p.d()
+	spot := p.Spot * math.Exp(-p.Dividend*p.Expiry)
+	strike := p.Strike * math.Exp(-p.Rate*p.Expiry)
+	if side == optionside.Call {
+		return spot*cdf(d1) - strike*cdf(d2)
+	}
+	return strike*cdf(-d2) - spot*cdf(-d1)
+}
+
+//ComputeGreeks Black-Scholes greeks of a European option, side is from optionside
+func ComputeGreeks(side string, p Params) (Greeks, error) {
+	if err := validateSide(side); err != nil {
+		return Greeks{}, err
+	}
+	if err := p.validate(); err != nil {
+		return Greeks{}, err
+	}
+	d1, d2 := p.d()
+	sqrtT := math.Sqrt(p.Expiry)
+	spotDiscount := math.Exp(-p.Dividend * p.Expiry)
+	strikeDiscount := math.Exp(-p.Rate * p.Expiry)
+	g := Greeks{
+		Gamma: spotDiscount * pdf(d1) / (p.Spot * p.Volatility * sqrtT),
+		Vega:  p.Spot * spotDiscount * pdf(d1) * sqrtT,
+	}
+	decay := -p.Spot * spotDiscount * pdf(d1) * p.Volatility / (2 * sqrtT)
+	if side == optionside.Call {
+		g.Delta = spotDiscount * cdf(d1)
+		g.Theta = decay - p.Rate*p.Strike*strikeDiscount*cdf(d2) + p.Dividend*p.Spot*spotDiscount*cdf(d1)
+		g.Rho = p.Strike * p.Expiry * strikeDiscount * cdf(d2)
+	} else {
+		g.Delta = -spotDiscount * cdf(-d1)
+		g.Theta = decay + p.Rate*p.Strike*strikeDiscount*cdf(-d2) - p.Dividend*p.Spot*spotDiscount*cdf(-d1)
+		g.Rho = -p.Strike * p.Expiry * strikeDiscount * cdf(-d2)
+	}
+	return g, nil
+}
+
+//ImpliedVolatility the volatility at which the option's theoretical value equals marketPrice.
+//Newton's method starts from p.Volatility, or 0.2 when it is not set, and falls back to bisection when it leaves the search range or stalls.
+//An error is returned when the volatility would be outside of the search range, above 500%, or the result does not reprice to marketPrice.
+func ImpliedVolatility(side string, marketPrice float64, p Params) (float64, error) {
+	if err := validateSide(side); err != nil {
+		return 0, err
+	}
+	//Volatility is only the initial guess, so it is not validated
+	guess := p.Volatility
+	p.Volatility = maxVolatility
+	if err := p.validate(); err != nil {
+		return 0, err
+	}
+	p.Volatility = guess
+	spot := p.Spot * math.Exp(-p.Dividend*p.Expiry)
+	strike := p.Strike * math.Exp(-p.Rate*p.Expiry)
+	lower, upper := math.Max(0, spot-strike), spot
+	if side == optionside.Put {
+		lower, upper = math.Max(0, strike-spot), strike
+	}
+	if marketPrice <= lower || marketPrice >= upper {
+		return 0, fmt.Errorf("price %v outside of no-arbitrage bounds (%v, %v)", marketPrice, lower, upper)
+	}
+	for _, bound := range []float64{minVolatility, maxVolatility} {
+		p.Volatility = bound
+		if got := price(side, p); bound == minVolatility && got > marketPrice || bound == maxVolatility && got < marketPrice {
+			return 0, fmt.Errorf("price %v needs a volatility outside of [%v, %v]", marketPrice, float64(minVolatility), float64(maxVolatility))
+		}
+	}
+	p.Volatility = guess
+	sigma := solve(side, marketPrice, p)
+	p.Volatility = sigma
+	if got := price(side, p); math.Abs(got-marketPrice) > priceTolerance {
+		return 0, fmt.Errorf("implied volatility did not converge: %v reprices to %v, want %v", sigma, got, marketPrice)
+	}
+	return sigma, nil
+}
+
+func solve(side string, target float64, p Params) float64 {
+	sigma := p.Volatility
+	if sigma <= minVolatility || sigma >= maxVolatility {
+		sigma = 0.2
+	}
+	for i := 0; i < ivIterations; i++ {
+		p.Volatility = sigma
+		diff := price(side, p) - target
+		if math.Abs(diff) < ivTolerance {
+			return sigma
+		}
+		d1, _ := p.d()
+		vega := p.Spot * math.Exp(-p.Dividend*p.Expiry) * pdf(d1) * math.Sqrt(p.Expiry)
+		if vega < ivTolerance {
+			break
+		}
+		sigma -= diff / vega
+		if sigma <= minVolatility || sigma >= maxVolatility {
+			break
+		}
+	}
+	//Bisection, the price increases with volatility
+	low, high := minVolatility, float64(maxVolatility)
+	for i := 0; i < 2*ivIterations; i++ {
+		sigma = (low + high) / 2
+		p.Volatility = sigma
+		diff := price(side, p) - target
+		if math.Abs(diff) < ivTolerance || high-low < ivTolerance {
+			break
+		}
+		if diff > 0 {
+			high = sigma
+		} else {
+			low = sigma
+		}
+	}
+	return sigma
+}
+
+//Mid midpoint of the contract's bid and ask
+func Mid(contract *iex.OptionContract) (float64, error) {
+	if contract.Bid <= 0 || contract.Ask <= 0 || contract.Ask < contract.Bid {
+		return 0, fmt.Errorf("option %s has no valid market: bid %v, ask %v", contract.ID, contract.Bid, contract.Ask)
+	}
+	return (contract.Bid + contract.Ask) / 2, nil
+}
+
+//YearsToExpiry time from asOf to the contract's expiration at 4:00 p.m. in New York, in years of 365 days
+func YearsToExpiry(contract *iex.OptionContract, asOf time.Time) float64 {
+	y, m, d := contract.Expiration.Date()
+	expiry := time.Date(y, m, d, 16, 0, 0, 0, calendar.Location)
+	return expiry.Sub(asOf).Hours() / 24 / daysPerYear
+}
+
+//ContractParams model inputs for a contract on underlying as of asOf, without Volatility.
+//rate and dividend are the continuously compounded risk-free rate and dividend yield.
+func ContractParams(contract *iex.OptionContract, underlying *iex.Quote, rate, dividend float64, asOf time.Time) Params {
+	return Params{
+		Spot:     underlying.LatestPrice,
+		Strike:   contract.StrikePrice,
+		Expiry:   YearsToExpiry(contract, asOf),
+		Rate:     rate,
+		Dividend: dividend,
+	}
+}
+
+//ContractImpliedVolatility implied volatility of the contract's bid/ask midpoint
+func ContractImpliedVolatility(contract *iex.OptionContract, underlying *iex.Quote, rate, dividend float64, asOf time.Time) (float64, error) {
+	mid, err := Mid(contract)
+	if err != nil {
+		return 0, err
+	}
+	return ImpliedVolatility(contract.Side, mid, ContractParams(contract, underlying, rate, dividend, asOf))
+}
+
+//cdf standard normal cumulative distribution function
+func cdf(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+//pdf standard normal probability density function
+func pdf(x float64) float64 {
+	return math.Exp(-x*x/2) / math.Sqrt(2*math.Pi)
+}
@@ -0,0 +1,144 @@
+package options
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	iex "github.com/Z-M-Huang/go-iex"
+	"github.com/Z-M-Huang/go-iex/calendar"
+	"github.com/Z-M-Huang/go-iex/enum/optionside"
+)
+
+func near(got, want, tolerance float64) bool {
+	return math.Abs(got-want) <= tolerance
+}
+
+func TestPrice(t *testing.T) {
+	tests := []struct {
+		name    string
+		side    string
+		p       Params
+		want    float64
+		wantErr bool
+	}{
+		//Hull, Options, Futures, and Other Derivatives, Example 15.6
+		{"Hull call", optionside.Call, Params{Spot: 42, Strike: 40, Expiry: 0.5, Rate: 0.1, Volatility: 0.2}, 4.7594, false},
+		{"Hull put", optionside.Put, Params{Spot: 42, Strike: 40, Expiry: 0.5, Rate: 0.1, Volatility: 0.2}, 0.8086, false},
+		{"At the money call", optionside.Call, Params{Spot: 100, Strike: 100, Expiry: 1, Rate: 0.05, Volatility: 0.2}, 10.4506, false},
+		{"At the money put", optionside.Put, Params{Spot: 100, Strike: 100, Expiry: 1, Rate: 0.05, Volatility: 0.2}, 5.5735, false},
+		//Haug, The Complete Guide to Option Pricing Formulas, generalized Black-Scholes example
+		{"Dividend put", optionside.Put, Params{Spot: 100, Strike: 95, Expiry: 0.5, Rate: 0.1, Dividend: 0.05, Volatility: 0.2}, 2.4648, false},
+		{"Unknown side", "straddle", Params{Spot: 100, Strike: 100, Expiry: 1, Volatility: 0.2}, 0, true},
+		{"Expired", optionside.Call, Params{Spot: 100, Strike: 100, Volatility: 0.2}, 0, true},
+		{"No volatility", optionside.Call, Params{Spot: 100, Strike: 100, Expiry: 1}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Price(tt.side, tt.p)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Price() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !near(got, tt.want, 1e-4) {
+				t.Errorf("Price() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComputeGreeks(t *testing.T) {
+	p := Params{Spot: 100, Strike: 100, Expiry: 1, Rate: 0.05, Volatility: 0.2}
+	tests := []struct {
+		name string
+		side string
+		want Greeks
+	}{
+		{"Call", optionside.Call, Greeks{Delta: 0.636831, Gamma: 0.018762, Theta: -6.414028, Vega: 37.524035, Rho: 53.232482}},
+		{"Put", optionside.Put, Greeks{Delta: -0.363169, Gamma: 0.018762, Theta: -1.657880, Vega: 37.524035, Rho: -41.890461}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ComputeGreeks(tt.side, p)
+			if err != nil {
+				t.Fatalf("ComputeGreeks() error = %v", err)
+			}
+			if !near(got.Delta, tt.want.Delta, 1e-5) || !near(got.Gamma, tt.want.Gamma, 1e-5) || !near(got.Theta, tt.want.Theta, 1e-5) ||
+				!near(got.Vega, tt.want.Vega, 1e-5) || !near(got.Rho, tt.want.Rho, 1e-5) {
+				t.Errorf("ComputeGreeks() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+	if _, err := ComputeGreeks(optionside.Call, Params{}); err == nil {
+		t.Errorf("ComputeGreeks() expected error for empty params")
+	}
+}
+
+func TestImpliedVolatility(t *testing.T) {
+	tests := []struct {
+		name    string
+		side    string
+		price   float64
+		p       Params
+		want    float64
+		wantErr bool
+	}{
+		{"Call", optionside.Call, 10.450584, Params{Spot: 100, Strike: 100, Expiry: 1, Rate: 0.05}, 0.2, false},
+		{"Put with guess", optionside.Put, 0.808599, Params{Spot: 42, Strike: 40, Expiry: 0.5, Rate: 0.1, Volatility: 0.5}, 0.2, false},
+		{"High volatility", optionside.Call, 61.451438, Params{Spot: 100, Strike: 100, Expiry: 1, Rate: 0.05}, 1.7, false},
+		//The guess has almost no vega, so the solver falls back to bisection
+		{"Deep out of the money", optionside.Call, 0.353236, Params{Spot: 50, Strike: 100, Expiry: 0.1, Volatility: 0.01}, 1.2, false},
+		{"Below intrinsic", optionside.Call, 1, Params{Spot: 110, Strike: 100, Expiry: 1}, 0, true},
+		{"Above spot", optionside.Call, 120, Params{Spot: 110, Strike: 100, Expiry: 1}, 0, true},
+		{"Expired", optionside.Put, 1, Params{Spot: 110, Strike: 100}, 0, true},
+		//Repricing needs a volatility of about 970%
+		{"Beyond max volatility", optionside.Call, 20, Params{Spot: 100, Strike: 100, Expiry: 1.0 / 365}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ImpliedVolatility(tt.side, tt.price, tt.p)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ImpliedVolatility() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !near(got, tt.want, 1e-3) {
+				t.Errorf("ImpliedVolatility() = %v, want %v", got, tt.want)
+			}
+			if err == nil {
+				p := tt.p
+				p.Volatility = got
+				if back, _ := Price(tt.side, p); !near(back, tt.price, 1e-6) {
+					t.Errorf("Price() at implied volatility = %v, want %v", back, tt.price)
+				}
+			}
+		})
+	}
+}
+
+func TestContractImpliedVolatility(t *testing.T) {
+	expiration := time.Date(2020, time.December, 18, 0, 0, 0, 0, time.UTC)
+	asOf := time.Date(2020, time.June, 19, 16, 0, 0, 0, calendar.Location)
+	contract := &iex.OptionContract{ID: "AAPL20201218C00100000", Expiration: expiration, StrikePrice: 100, Side: optionside.Call, Bid: 6.8, Ask: 7.0}
+	underlying := &iex.Quote{LatestPrice: 100}
+
+	//182 days and the hour gained when daylight saving time ends
+	if want := (182*24 + 1) / 24.0 / 365; !near(YearsToExpiry(contract, asOf), want, 1e-9) {
+		t.Errorf("YearsToExpiry() = %v, want %v", YearsToExpiry(contract, asOf), want)
+	}
+	got, err := ContractImpliedVolatility(contract, underlying, 0.01, 0, asOf)
+	if err != nil {
+		t.Fatalf("ContractImpliedVolatility() error = %v", err)
+	}
+	p := ContractParams(contract, underlying, 0.01, 0, asOf)
+	p.Volatility = got
+	if price, _ := Price(optionside.Call, p); !near(price, 6.9, 1e-6) {
+		t.Errorf("Price() at implied volatility = %v, want mid 6.9", price)
+	}
+
+	for _, c := range []*iex.OptionContract{
+		{Bid: 0, Ask: 1},
+		{Bid: 2, Ask: 1},
+	} {
+		if _, err := Mid(c); err == nil {
+			t.Errorf("Mid(%v, %v) expected error", c.Bid, c.Ask)
+		}
+	}
+}
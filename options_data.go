@@ -0,0 +1,69 @@
+package iex
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+//expirationLayout layout of option expiration dates
+const expirationLayout = "20060102"
+
+//OptionContract https://iexcloud.io/docs/api/#end-of-day-options
+//Greeks and ImpliedVolatility are nil when IEX does not provide them.
+type OptionContract struct {
+	Symbol            string    `json:"symbol"`
+	ID                string    `json:"id"`
+	Expiration        time.Time `json:"-"`
+	ContractSize      int       `json:"contractSize"`
+	StrikePrice       float64   `json:"strikePrice"`
+	ClosingPrice      float64   `json:"closingPrice"`
+	Side              string    `json:"side"`
+	Type              string    `json:"type"`
+	Volume            int       `json:"volume"`
+	OpenInterest      int       `json:"openInterest"`
+	Bid               float64   `json:"bid"`
+	Ask               float64   `json:"ask"`
+	ImpliedVolatility *float64  `json:"impliedVolatility"`
+	Delta             *float64  `json:"delta"`
+	Gamma             *float64  `json:"gamma"`
+	Theta             *float64  `json:"theta"`
+	Vega              *float64  `json:"vega"`
+	Rho               *float64  `json:"rho"`
+	LastUpdated       string    `json:"lastUpdated"`
+	IsAdjusted        bool      `json:"isAdjusted"`
+}
+
+//UnmarshalJSON implements the Unmarshaler interface for OptionContract, parsing expirationDate into Expiration
+func (c *OptionContract) UnmarshalJSON(data []byte) error {
+	type contract OptionContract
+	aux := struct {
+		*contract
+		ExpirationDate string `json:"expirationDate"`
+	}{contract: (*contract)(c)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if aux.ExpirationDate == "" {
+		return nil
+	}
+	expiration, err := time.Parse(expirationLayout, aux.ExpirationDate)
+	if err != nil {
+		return fmt.Errorf("option %s expiration: %w", c.ID, err)
+	}
+	c.Expiration = expiration
+	return nil
+}
+
+//MarshalJSON implements the Marshaler interface for OptionContract
+func (c OptionContract) MarshalJSON() ([]byte, error) {
+	type contract OptionContract
+	aux := struct {
+		contract
+		ExpirationDate string `json:"expirationDate,omitempty"`
+	}{contract: contract(c)}
+	if !c.Expiration.IsZero() {
+		aux.ExpirationDate = c.Expiration.Format(expirationLayout)
+	}
+	return json.Marshal(aux)
+}
@@ -0,0 +1,80 @@
+package iex
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+//RateLimiter blocks until the next request may be sent.
+//A single RateLimiter may be shared by multiple Client instances to split one budget between them.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+//TokenBucket token bucket RateLimiter, safe for concurrent use
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+//NewTokenBucket allow requestsPerSecond requests on average with bursts of up to burst requests
+func NewTokenBucket(requestsPerSecond float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+//Wait blocks until a token is available or ctx is done
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if b.rate <= 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+	//Reserve a token even when none is available so waiters are served in order
+	b.tokens--
+	wait := time.Duration(0)
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	b.mu.Unlock()
+
+	if err := sleepContext(ctx, wait); err != nil {
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+//WithRateLimiter wait for limiter before sending every request, including retries
+func WithRateLimiter(limiter RateLimiter) Option {
+	return func(c *Client) {
+		c.limiter = limiter
+	}
+}
+
+//WithRateLimit limit the client to requestsPerSecond requests with bursts of up to burst requests
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return WithRateLimiter(NewTokenBucket(requestsPerSecond, burst))
+}
@@ -0,0 +1,50 @@
+package iex
+
+//Symbol https://iexcloud.io/docs/api/#symbols
+type Symbol struct {
+	Symbol         string `json:"symbol"`
+	Exchange       string `json:"exchange"`
+	ExchangeSuffix string `json:"exchangeSuffix"`
+	ExchangeName   string `json:"exchangeName"`
+	Name           string `json:"name"`
+	Date           string `json:"date"`
+	Type           string `json:"type"`
+	IEXID          string `json:"iexId"`
+	Region         string `json:"region"`
+	Currency       string `json:"currency"`
+	IsEnabled      bool   `json:"isEnabled"`
+	FIGI           string `json:"figi"`
+	CIK            string `json:"cik"`
+}
+
+//IEXSymbol https://iexcloud.io/docs/api/#iex-symbols
+type IEXSymbol struct {
+	Symbol    string `json:"symbol"`
+	Date      string `json:"date"`
+	IsEnabled bool   `json:"isEnabled"`
+}
+
+//Exchange https://iexcloud.io/docs/api/#international-exchanges
+type Exchange struct {
+	Exchange       string `json:"exchange"`
+	Region         string `json:"region"`
+	Description    string `json:"description"`
+	MIC            string `json:"mic"`
+	ExchangeSuffix string `json:"exchangeSuffix"`
+}
+
+//Sector https://iexcloud.io/docs/api/#sectors
+type Sector struct {
+	Name string `json:"name"`
+}
+
+//Tag https://iexcloud.io/docs/api/#tags
+type Tag struct {
+	Name string `json:"name"`
+}
+
+//USDate https://iexcloud.io/docs/api/#u-s-holidays-and-trading-dates
+type USDate struct {
+	Date           string `json:"date"`
+	SettlementDate string `json:"settlementDate"`
+}
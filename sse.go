@@ -0,0 +1,466 @@
+package iex
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+//maxSeenNews news items remembered by SubscribeNews to skip duplicates
+const maxSeenNews = 1000
+
+//Stream server-sent events subscription https://iexcloud.io/docs/api/#sse-streaming
+//It reconnects with backoff until closed, its context is done or IEX rejects the subscription.
+type Stream struct {
+	client     *Client
+	httpClient *http.Client
+	path       string
+	params     url.Values
+	handle     func(data []byte) error
+	onError    func(error)
+	reconnect  RetryPolicy
+	cancel     context.CancelFunc
+	done       chan struct{}
+	changed    chan struct{}
+	mu         sync.Mutex
+	symbols    []string
+	connCancel context.CancelFunc
+	closed     bool
+	err        error
+}
+
+//StreamOption configures a Stream
+type StreamOption func(*Stream)
+
+//WithStreamErrorHandler receive errors the stream recovers from, such as disconnects and undecodable events
+func WithStreamErrorHandler(fn func(error)) StreamOption {
+	return func(s *Stream) {
+		s.onError = fn
+	}
+}
+
+//WithReconnectBackoff wait from base up to max between reconnect attempts. Defaults to 1s up to 30s.
+func WithReconnectBackoff(base, max time.Duration) StreamOption {
+	return func(s *Stream) {
+		s.reconnect.BaseBackoff = base
+		s.reconnect.MaxBackoff = max
+	}
+}
+
+//SubscribeQuotes stream quotes for symbols from one of the ssechannel channels
+func (o *Client) SubscribeQuotes(ctx context.Context, channel string, symbols []string, fn func(*Quote), opts ...StreamOption) *Stream {
+	return o.subscribe(ctx, "/"+channel, nil, symbols, func(data []byte) error {
+		var quotes []*Quote
+		if err := decodeEvent(data, &quotes); err != nil {
+			return err
+		}
+		for _, quote := range quotes {
+			fn(quote)
+		}
+		return nil
+	}, opts)
+}
+
+//SubscribeDeep stream DEEP channels from ssechannel for symbols, multiplexed on one connection
+func (o *Client) SubscribeDeep(ctx context.Context, channels []string, symbols []string, fn func(*DeepEvent), opts ...StreamOption) *Stream {
+	params := url.Values{}
+	params.Add("channels", strings.Join(channels, ","))
+	return o.subscribe(ctx, "/deep", params, symbols, func(data []byte) error {
+		var events []*DeepEvent
+		if err := decodeEvent(data, &events); err != nil {
+			return err
+		}
+		//A payload that fails to decode is reported without dropping the rest of the frame
+		var errs eventErrors
+		for _, event := range events {
+			if err := event.decodeData(); err != nil {
+				errs = append(errs, fmt.Errorf("%s %s event %d: %w", event.Symbol, event.MessageType, event.Seq, err))
+				continue
+			}
+			fn(event)
+		}
+		if len(errs) > 0 {
+			return errs
+		}
+		return nil
+	}, opts)
+}
+
+//SubscribeTOPS stream top of book for symbols https://iexcloud.io/docs/api/#tops
+func (o *Client) SubscribeTOPS(ctx context.Context, symbols []string, fn func(*TOPS), opts ...StreamOption) *Stream {
+	return o.subscribe(ctx, "/tops", nil, symbols, func(data []byte) error {
+		var tops []*TOPS
+		if err := decodeEvent(data, &tops); err != nil {
+			return err
+		}
+		for _, t := range tops {
+			fn(t)
+		}
+		return nil
+	}, opts)
+}
+
+//SubscribeLast stream last sales for symbols https://iexcloud.io/docs/api/#last
+func (o *Client) SubscribeLast(ctx context.Context, symbols []string, fn func(*Last), opts ...StreamOption) *Stream {
+	return o.subscribe(ctx, "/last", nil, symbols, func(data []byte) error {
+		var lasts []*Last
+		if err := decodeEvent(data, &lasts); err != nil {
+			return err
+		}
+		for _, last := range lasts {
+			fn(last)
+		}
+		return nil
+	}, opts)
+}
+
+//SubscribeCryptoQuotes stream cryptocurrency quotes for symbols https://iexcloud.io/docs/api/#cryptocurrency-quote
+func (o *Client) SubscribeCryptoQuotes(ctx context.Context, symbols []string, fn func(*CryptoQuote), opts ...StreamOption) *Stream {
+	return o.subscribe(ctx, "/cryptoQuotes", nil, symbols, func(data []byte) error {
+		var quotes []*CryptoQuote
+		if err := decodeEvent(data, &quotes); err != nil {
+			return err
+		}
+		for _, quote := range quotes {
+			fn(quote)
+		}
+		return nil
+	}, opts)
+}
+
+//SubscribeCryptoBook stream cryptocurrency order books for symbols https://iexcloud.io/docs/api/#cryptocurrency-book
+func (o *Client) SubscribeCryptoBook(ctx context.Context, symbols []string, fn func(*CryptoBook), opts ...StreamOption) *Stream {
+	return o.subscribe(ctx, "/cryptoBook", nil, symbols, func(data []byte) error {
+		var books []*CryptoBook
+		if err := decodeEvent(data, &books); err != nil {
+			return err
+		}
+		for _, book := range books {
+			fn(book)
+		}
+		return nil
+	}, opts)
+}
+
+//SubscribeCryptoEvents stream cryptocurrency order book events for symbols https://iexcloud.io/docs/api/#cryptocurrency-events
+func (o *Client) SubscribeCryptoEvents(ctx context.Context, symbols []string, fn func(*CryptoEvent), opts ...StreamOption) *Stream {
+	return o.subscribe(ctx, "/cryptoEvents", nil, symbols, func(data []byte) error {
+		var events []*CryptoEvent
+		if err := decodeEvent(data, &events); err != nil {
+			return err
+		}
+		for _, event := range events {
+			fn(event)
+		}
+		return nil
+	}, opts)
+}
+
+//SubscribeNews stream news for symbols https://iexcloud.io/docs/api/#sse-streaming
+//Items already delivered are skipped when IEX resends them, e.g. after a reconnect.
+func (o *Client) SubscribeNews(ctx context.Context, symbols []string, fn func(*NewsItem), opts ...StreamOption) *Stream {
+	seen := make(map[string]bool)
+	var order []string
+	return o.subscribe(ctx, "/news-stream", nil, symbols, func(data []byte) error {
+		var items []*NewsItem
+		if err := decodeEvent(data, &items); err != nil {
+			return err
+		}
+		for _, item := range items {
+			key := item.key()
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			order = append(order, key)
+			if len(order) > maxSeenNews {
+				delete(seen, order[0])
+				order = order[1:]
+			}
+			fn(item)
+		}
+		return nil
+	}, opts)
+}
+
+func (o *Client) subscribe(ctx context.Context, path string, params url.Values, symbols []string, handle func([]byte) error, opts []StreamOption) *Stream {
+	//Streams stay open indefinitely, so the request timeout must not apply
+	hc := *o.client
+	hc.Timeout = 0
+	s := &Stream{
+		client:     o,
+		httpClient: &hc,
+		path:       path,
+		params:     params,
+		handle:     handle,
+		reconnect: RetryPolicy{
+			BaseBackoff: time.Second,
+			MaxBackoff:  30 * time.Second,
+			Jitter:      0.2,
+		},
+		done:    make(chan struct{}),
+		changed: make(chan struct{}, 1),
+	}
+	s.AddSymbols(symbols...)
+	for _, opt := range opts {
+		opt(s)
+	}
+	ctx, s.cancel = context.WithCancel(ctx)
+	go s.run(ctx)
+	return s
+}
+
+//Symbols currently subscribed symbols
+func (s *Stream) Symbols() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.symbols...)
+}
+
+//AddSymbols subscribe to more symbols, reconnecting the stream if the subscription changed
+func (s *Stream) AddSymbols(symbols ...string) {
+	s.mu.Lock()
+	changed := false
+	for _, symbol := range symbols {
+		if indexOf(s.symbols, symbol) < 0 {
+			s.symbols = append(s.symbols, symbol)
+			changed = true
+		}
+	}
+	s.mu.Unlock()
+	if changed {
+		s.notifyChanged()
+	}
+}
+
+//RemoveSymbols unsubscribe from symbols, reconnecting the stream if the subscription changed
+func (s *Stream) RemoveSymbols(symbols ...string) {
+	s.mu.Lock()
+	changed := false
+	for _, symbol := range symbols {
+		if i := indexOf(s.symbols, symbol); i >= 0 {
+			s.symbols = append(s.symbols[:i], s.symbols[i+1:]...)
+			changed = true
+		}
+	}
+	s.mu.Unlock()
+	if changed {
+		s.notifyChanged()
+	}
+}
+
+//Done closed once the stream stopped
+func (s *Stream) Done() <-chan struct{} {
+	return s.done
+}
+
+//Err error that stopped the stream, nil while it is running or after Close
+func (s *Stream) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+//Close stop the stream and wait for it to disconnect
+func (s *Stream) Close() error {
+	s.mu.Lock()
+	s.closed = true
+	s.mu.Unlock()
+	s.cancel()
+	<-s.done
+	return nil
+}
+
+func (s *Stream) notifyChanged() {
+	s.mu.Lock()
+	if s.connCancel != nil {
+		s.connCancel()
+	}
+	s.mu.Unlock()
+	select {
+	case s.changed <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Stream) run(ctx context.Context) {
+	defer close(s.done)
+	attempt := 0
+	for {
+		//Drain pending change notifications, the current symbols are read below
+		select {
+		case <-s.changed:
+		default:
+		}
+		//Read the symbols and install connCancel together, so any later change cancels this connection
+		connCtx, connCancel := context.WithCancel(ctx)
+		s.mu.Lock()
+		symbols := append([]string(nil), s.symbols...)
+		s.connCancel = connCancel
+		s.mu.Unlock()
+		if len(symbols) == 0 {
+			connCancel()
+			select {
+			case <-ctx.Done():
+				s.stop(ctx.Err())
+				return
+			case <-s.changed:
+				continue
+			}
+		}
+
+		connected, err := s.connect(connCtx, symbols)
+		symbolsChanged := connCtx.Err() != nil
+		connCancel()
+		if ctx.Err() != nil {
+			s.stop(ctx.Err())
+			return
+		}
+		if symbolsChanged {
+			attempt = 0
+			continue
+		}
+		var apiErr APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode >= 400 && apiErr.StatusCode < 500 && apiErr.StatusCode != http.StatusTooManyRequests {
+			s.stop(err)
+			return
+		}
+		s.reportError(err)
+
+		if connected {
+			attempt = 0
+		}
+		attempt++
+		timer := time.NewTimer(s.reconnect.backoff(attempt, nil))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			s.stop(ctx.Err())
+			return
+		case <-s.changed:
+			timer.Stop()
+			attempt = 0
+		case <-timer.C:
+		}
+	}
+}
+
+func (s *Stream) stop(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		s.err = err
+	}
+}
+
+//eventErrors errors of individual events in a frame whose other events were delivered
+type eventErrors []error
+
+func (e eventErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (s *Stream) reportError(err error) {
+	if err == nil || s.onError == nil {
+		return
+	}
+	if errs, ok := err.(eventErrors); ok {
+		for _, err := range errs {
+			s.onError(err)
+		}
+		return
+	}
+	s.onError(err)
+}
+
+//connect reads events until the connection drops, reporting whether it was established
+func (s *Stream) connect(ctx context.Context, symbols []string) (bool, error) {
+	params := url.Values{}
+	for k, v := range s.params {
+		params[k] = v
+	}
+	params.Set("token", s.client.sk)
+	params.Set("symbols", strings.Join(symbols, ","))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s%s?%s", s.client.sseURL, s.path, params.Encode()), nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if s.client.userAgent != "" {
+		req.Header.Set("User-Agent", s.client.userAgent)
+	}
+	if s.client.limiter != nil {
+		if err := s.client.limiter.Wait(ctx); err != nil {
+			return false, err
+		}
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		contentBytes, _ := ioutil.ReadAll(resp.Body)
+		return false, APIError{
+			StatusCode: resp.StatusCode,
+			Message:    string(contentBytes),
+			Attempts:   1,
+		}
+	}
+
+	reader := bufio.NewReader(resp.Body)
+	var data [][]byte
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return true, err
+		}
+		line = bytes.TrimRight(line, "\r\n")
+		switch {
+		case len(line) == 0:
+			if len(data) > 0 {
+				if err := s.handle(bytes.Join(data, []byte("\n"))); err != nil {
+					s.reportError(err)
+				}
+				data = nil
+			}
+		case bytes.HasPrefix(line, []byte("data:")):
+			data = append(data, bytes.TrimPrefix(line[len("data:"):], []byte(" ")))
+		}
+	}
+}
+
+//decodeEvent decode an event holding either a single object or an array of them into out, a pointer to a slice
+func decodeEvent(data []byte, out interface{}) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '{' {
+		data = append(append([]byte("["), data...), ']')
+	}
+	return json.Unmarshal(data, out)
+}
+
+func indexOf(symbols []string, symbol string) int {
+	for i, s := range symbols {
+		if s == symbol {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,375 @@
+package iex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Z-M-Huang/go-iex/enum/ssechannel"
+)
+
+//sseServer serves frames to every connection and then closes it, recording the symbols requested
+type sseServer struct {
+	*httptest.Server
+	mu      sync.Mutex
+	symbols []string
+}
+
+func newSSEServer(t *testing.T, path string, statusCode int, frames func(r *http.Request) []string) *sseServer {
+	s := &sseServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			t.Errorf("request path = %v, want %v", r.URL.Path, path)
+		}
+		symbols := r.URL.Query().Get("symbols")
+		s.mu.Lock()
+		s.symbols = append(s.symbols, symbols)
+		s.mu.Unlock()
+		if statusCode != http.StatusOK {
+			http.Error(w, "rejected", statusCode)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, frame := range frames(r) {
+			fmt.Fprint(w, frame)
+			w.(http.Flusher).Flush()
+		}
+	}))
+	return s
+}
+
+func (s *sseServer) connections() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.symbols...)
+}
+
+func TestClient_SubscribeQuotes(t *testing.T) {
+	srv := newSSEServer(t, "/stocksUS", http.StatusOK, func(r *http.Request) []string {
+		var frames []string
+		for _, symbol := range strings.Split(r.URL.Query().Get("symbols"), ",") {
+			frames = append(frames, fmt.Sprintf(": keep-alive\ndata: [{\"symbol\":\"%s\",\"latestPrice\":1.5}]\n\n", symbol))
+		}
+		return append(frames, "data: not json\n\n")
+	})
+	defer srv.Close()
+
+	//Every reconnect delivers more events, drop them when the test is not reading so the stream never blocks
+	quotes := make(chan *Quote, 100)
+	errs := make(chan error, 100)
+	o := NewClient("", WithSSEURL(srv.URL), WithVersion(""))
+	s := o.SubscribeQuotes(context.Background(), ssechannel.StocksUS, []string{"AAPL"}, func(q *Quote) {
+		select {
+		case quotes <- q:
+		default:
+		}
+	}, WithReconnectBackoff(time.Millisecond, 10*time.Millisecond), WithStreamErrorHandler(func(err error) {
+		select {
+		case errs <- err:
+		default:
+		}
+	}))
+
+	if q := <-quotes; q.Symbol != "AAPL" || q.LatestPrice != 1.5 {
+		t.Errorf("quote = %v, want AAPL at 1.5", q)
+	}
+	if err := <-errs; err == nil {
+		t.Errorf("expected decode error")
+	}
+
+	s.AddSymbols("MSFT", "AAPL")
+	s.RemoveSymbols("AAPL")
+	if got := s.Symbols(); len(got) != 1 || got[0] != "MSFT" {
+		t.Errorf("Stream.Symbols() = %v, want [MSFT]", got)
+	}
+	deadline := time.After(5 * time.Second)
+	for {
+		select {
+		case q := <-quotes:
+			if q.Symbol != "MSFT" {
+				continue
+			}
+		case <-deadline:
+			t.Fatalf("no quote after changing symbols")
+		}
+		break
+	}
+
+	if err := s.Close(); err != nil {
+		t.Errorf("Stream.Close() error = %v", err)
+	}
+	if err := s.Err(); err != nil {
+		t.Errorf("Stream.Err() = %v, want nil after Close", err)
+	}
+	if len(srv.connections()) < 2 {
+		t.Errorf("connections = %v, want reconnects", srv.connections())
+	}
+}
+
+func TestClient_SubscribeQuotesSymbolsChanged(t *testing.T) {
+	//Connections stay open until the client disconnects, so only a reconnect picks up new symbols
+	connected := make(chan string, 100)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, ": keep-alive\n\n")
+		w.(http.Flusher).Flush()
+		connected <- r.URL.Query().Get("symbols")
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	o := NewClient("", WithSSEURL(srv.URL))
+	s := o.SubscribeQuotes(context.Background(), ssechannel.StocksUS, []string{"AAPL"}, func(q *Quote) {}, WithReconnectBackoff(time.Millisecond, 10*time.Millisecond))
+	defer s.Close()
+	//Right after subscribing, the change can race with the first connection
+	s.AddSymbols("MSFT")
+	waitForSymbols := func(want string) {
+		deadline := time.After(5 * time.Second)
+		for {
+			select {
+			case got := <-connected:
+				if got == want {
+					return
+				}
+			case <-deadline:
+				t.Fatalf("no connection with symbols %v", want)
+			}
+		}
+	}
+	waitForSymbols("AAPL,MSFT")
+
+	s.RemoveSymbols("AAPL")
+	waitForSymbols("MSFT")
+}
+
+func TestClient_SubscribeQuotesRejected(t *testing.T) {
+	srv := newSSEServer(t, "/stable/stocksUS5Second", http.StatusUnauthorized, nil)
+	defer srv.Close()
+
+	o := NewClient("", WithSSEURL(srv.URL))
+	s := o.SubscribeQuotes(context.Background(), ssechannel.StocksUS5Second, []string{"AAPL"}, func(q *Quote) {})
+	select {
+	case <-s.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("stream did not stop")
+	}
+	var apiErr APIError
+	if !errors.As(s.Err(), &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Stream.Err() = %v, want 401", s.Err())
+	}
+}
+
+func TestClient_SubscribeQuotesContext(t *testing.T) {
+	srv := newSSEServer(t, "/stable/stocksUS", http.StatusOK, func(r *http.Request) []string { return nil })
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	o := NewClient("", WithSSEURL(srv.URL))
+	s := o.SubscribeQuotes(ctx, ssechannel.StocksUS, nil, func(q *Quote) {})
+	cancel()
+	<-s.Done()
+	if !errors.Is(s.Err(), context.Canceled) {
+		t.Errorf("Stream.Err() = %v, want %v", s.Err(), context.Canceled)
+	}
+	if len(srv.connections()) != 0 {
+		t.Errorf("connections = %v, want none without symbols", srv.connections())
+	}
+}
+
+func TestClient_SubscribeDeep(t *testing.T) {
+	srv := newSSEServer(t, "/stable/deep", http.StatusOK, func(r *http.Request) []string {
+		if got := r.URL.Query().Get("channels"); got != "book,trades,op-halt-status,ssr-status" {
+			t.Errorf("channels = %v", got)
+		}
+		return []string{
+			`data: [{"symbol":"AAPL","messageType":"book","data":{"bids":[{"price":1.5,"size":100,"timestamp":1494538496261}],"asks":[]},"seq":1}]` + "\n\n",
+			`data: {"symbol":"AAPL","messageType":"trades","data":{"price":1.5,"size":100,"tradeId":517341294},"seq":2}` + "\n\n",
+			`data: [{"symbol":"AAPL","messageType":"opHaltStatus","data":{"isHalted":true},"seq":3},{"symbol":"AAPL","messageType":"trades","data":{"price":"bad"},"seq":5},{"symbol":"AAPL","messageType":"ssr","data":{"isSSR":true,"detail":"N"},"seq":4}]` + "\n\n",
+		}
+	})
+	defer srv.Close()
+
+	events := make(chan *DeepEvent, 100)
+	errs := make(chan error, 100)
+	o := NewClient("", WithSSEURL(srv.URL))
+	s := o.SubscribeDeep(context.Background(), []string{ssechannel.Book, ssechannel.Trades, ssechannel.OpHaltStatus, ssechannel.SSRStatus}, []string{"AAPL"}, func(e *DeepEvent) {
+		select {
+		case events <- e:
+		default:
+		}
+	}, WithStreamErrorHandler(func(err error) {
+		select {
+		case errs <- err:
+		default:
+		}
+	}))
+	defer s.Close()
+
+	if e := <-events; e.Seq != 1 || e.Book == nil || len(e.Book.Bids) != 1 || e.Book.Bids[0].Price != 1.5 {
+		t.Errorf("book event = %+v", e)
+	}
+	if e := <-events; e.Seq != 2 || len(e.Trades) != 1 || e.Trades[0].TradeID != 517341294 {
+		t.Errorf("trades event = %+v", e)
+	}
+	if e := <-events; e.Seq != 3 || e.OpHaltStatus == nil || !e.OpHaltStatus.IsHalted {
+		t.Errorf("op halt status event = %+v", e)
+	}
+	if e := <-events; e.Seq != 4 || e.SSRStatus == nil || !e.SSRStatus.IsSSR || e.SSRStatus.Detail != "N" {
+		t.Errorf("ssr status event = %+v", e)
+	}
+	if err := <-errs; !strings.Contains(err.Error(), "event 5") {
+		t.Errorf("error = %v, want the bad trades event", err)
+	}
+}
+
+func TestClient_SubscribeTOPSAndLast(t *testing.T) {
+	tops := newSSEServer(t, "/stable/tops", http.StatusOK, func(r *http.Request) []string {
+		return []string{`data: [{"symbol":"SNAP","bidPrice":10.5,"askPrice":10.6,"volume":100}]` + "\n\n"}
+	})
+	defer tops.Close()
+	last := newSSEServer(t, "/stable/last", http.StatusOK, func(r *http.Request) []string {
+		return []string{`data: [{"symbol":"SNAP","price":10.55,"size":200,"time":1494538496261}]` + "\n\n"}
+	})
+	defer last.Close()
+
+	//The servers replay on every reconnect, drop events the test is not reading so the streams never block
+	gotTOPS := make(chan *TOPS, 10)
+	s := NewClient("", WithSSEURL(tops.URL)).SubscribeTOPS(context.Background(), []string{"SNAP"}, func(t *TOPS) {
+		select {
+		case gotTOPS <- t:
+		default:
+		}
+	})
+	defer s.Close()
+	select {
+	case got := <-gotTOPS:
+		if got.Symbol != "SNAP" || got.BidPrice != 10.5 || got.Volume != 100 {
+			t.Errorf("TOPS = %+v", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no TOPS received")
+	}
+
+	gotLast := make(chan *Last, 10)
+	s = NewClient("", WithSSEURL(last.URL)).SubscribeLast(context.Background(), []string{"SNAP"}, func(l *Last) {
+		select {
+		case gotLast <- l:
+		default:
+		}
+	})
+	defer s.Close()
+	select {
+	case got := <-gotLast:
+		if got.Symbol != "SNAP" || got.Price != 10.55 || got.Size != 200 {
+			t.Errorf("Last = %+v", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no Last received")
+	}
+}
+
+func TestClient_SubscribeCrypto(t *testing.T) {
+	quotes := newSSEServer(t, "/stable/cryptoQuotes", http.StatusOK, func(r *http.Request) []string {
+		return []string{`data: [{"symbol":"BTCUSD","latestPrice":"11451.47","latestVolume":"0.012","latestUpdate":1598374495000,"high":null}]` + "\n\n"}
+	})
+	defer quotes.Close()
+	book := newSSEServer(t, "/stable/cryptoBook", http.StatusOK, func(r *http.Request) []string {
+		return []string{`data: {"symbol":"BTCUSD","bids":[{"price":"11450.65","size":"0.036","timestamp":1598374494050}],"asks":[]}` + "\n\n"}
+	})
+	defer book.Close()
+	events := newSSEServer(t, "/stable/cryptoEvents", http.StatusOK, func(r *http.Request) []string {
+		return []string{`data: [{"symbol":"BTCUSD","eventType":"done","timestamp":1598374494050,"reason":"canceled","size":"0","price":"11441.3","side":"sell"}]` + "\n\n"}
+	})
+	defer events.Close()
+
+	//The servers replay on every reconnect, drop events the test is not reading so the streams never block
+	gotQuote := make(chan *CryptoQuote, 10)
+	s := NewClient("", WithSSEURL(quotes.URL)).SubscribeCryptoQuotes(context.Background(), []string{"BTCUSD"}, func(q *CryptoQuote) {
+		select {
+		case gotQuote <- q:
+		default:
+		}
+	})
+	defer s.Close()
+	select {
+	case got := <-gotQuote:
+		if got.Symbol != "BTCUSD" || got.LatestPrice != 11451.47 || got.LatestVolume != 0.012 {
+			t.Errorf("CryptoQuote = %+v", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no CryptoQuote received")
+	}
+
+	gotBook := make(chan *CryptoBook, 10)
+	s = NewClient("", WithSSEURL(book.URL)).SubscribeCryptoBook(context.Background(), []string{"BTCUSD"}, func(b *CryptoBook) {
+		select {
+		case gotBook <- b:
+		default:
+		}
+	})
+	defer s.Close()
+	select {
+	case got := <-gotBook:
+		if got.Symbol != "BTCUSD" || len(got.Bids) != 1 || got.Bids[0].Size != 0.036 {
+			t.Errorf("CryptoBook = %+v", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no CryptoBook received")
+	}
+
+	gotEvent := make(chan *CryptoEvent, 10)
+	s = NewClient("", WithSSEURL(events.URL)).SubscribeCryptoEvents(context.Background(), []string{"BTCUSD"}, func(e *CryptoEvent) {
+		select {
+		case gotEvent <- e:
+		default:
+		}
+	})
+	defer s.Close()
+	select {
+	case got := <-gotEvent:
+		if got.EventType != "done" || got.Price != 11441.3 || got.Side != "sell" {
+			t.Errorf("CryptoEvent = %+v", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no CryptoEvent received")
+	}
+}
+
+func TestClient_SubscribeNews(t *testing.T) {
+	var mu sync.Mutex
+	connections := 0
+	srv := newSSEServer(t, "/stable/news-stream", http.StatusOK, func(r *http.Request) []string {
+		mu.Lock()
+		defer mu.Unlock()
+		connections++
+		//Every connection replays the previous items
+		var items []string
+		for i := 1; i <= connections; i++ {
+			items = append(items, fmt.Sprintf(`{"datetime":1545215400000,"headline":"News %d","url":"https://example.com/%d","related":"AAPL"}`, i, i))
+		}
+		return []string{"data: [" + strings.Join(items, ",") + "]\n\n"}
+	})
+	defer srv.Close()
+
+	news := make(chan *NewsItem, 100)
+	o := NewClient("", WithSSEURL(srv.URL))
+	s := o.SubscribeNews(context.Background(), []string{"AAPL"}, func(n *NewsItem) {
+		select {
+		case news <- n:
+		default:
+		}
+	}, WithReconnectBackoff(time.Millisecond, time.Millisecond))
+	defer s.Close()
+
+	for i := 1; i <= 3; i++ {
+		if n := <-news; n.Headline != fmt.Sprintf("News %d", i) {
+			t.Errorf("news = %v, want News %d", n.Headline, i)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package iex
+
+//FiscalPeriod fiscal period a financial report covers
+type FiscalPeriod struct {
+	ReportDate    string `json:"reportDate"`
+	FilingType    string `json:"filingType"`
+	FiscalDate    string `json:"fiscalDate"`
+	FiscalQuarter int    `json:"fiscalQuarter"`
+	FiscalYear    int    `json:"fiscalYear"`
+	Currency      string `json:"currency"`
+}
+
+//IncomeStatement https://iexcloud.io/docs/api/#income-statement
+type IncomeStatement struct {
+	FiscalPeriod
+	TotalRevenue           float64 `json:"totalRevenue"`
+	CostOfRevenue          float64 `json:"costOfRevenue"`
+	GrossProfit            float64 `json:"grossProfit"`
+	ResearchAndDevelopment float64 `json:"researchAndDevelopment"`
+	SellingGeneralAndAdmin float64 `json:"sellingGeneralAndAdmin"`
+	OperatingExpense       float64 `json:"operatingExpense"`
+	OperatingIncome        float64 `json:"operatingIncome"`
+	OtherIncomeExpenseNet  float64 `json:"otherIncomeExpenseNet"`
+	EBIT                   float64 `json:"ebit"`
+	InterestIncome         float64 `json:"interestIncome"`
+	PretaxIncome           float64 `json:"pretaxIncome"`
+	IncomeTax              float64 `json:"incomeTax"`
+	MinorityInterest       float64 `json:"minorityInterest"`
+	NetIncome              float64 `json:"netIncome"`
+	NetIncomeBasic         float64 `json:"netIncomeBasic"`
+}
+
+//BalanceSheet https://iexcloud.io/docs/api/#balance-sheet
+type BalanceSheet struct {
+	FiscalPeriod
+	CurrentCash             float64 `json:"currentCash"`
+	ShortTermInvestments    float64 `json:"shortTermInvestments"`
+	Receivables             float64 `json:"receivables"`
+	Inventory               float64 `json:"inventory"`
+	OtherCurrentAssets      float64 `json:"otherCurrentAssets"`
+	CurrentAssets           float64 `json:"currentAssets"`
+	LongTermInvestments     float64 `json:"longTermInvestments"`
+	PropertyPlantEquipment  float64 `json:"propertyPlantEquipment"`
+	Goodwill                float64 `json:"goodwill"`
+	IntangibleAssets        float64 `json:"intangibleAssets"`
+	OtherAssets             float64 `json:"otherAssets"`
+	TotalAssets             float64 `json:"totalAssets"`
+	AccountsPayable         float64 `json:"accountsPayable"`
+	CurrentLongTermDebt     float64 `json:"currentLongTermDebt"`
+	OtherCurrentLiabilities float64 `json:"otherCurrentLiabilities"`
+	TotalCurrentLiabilities float64 `json:"totalCurrentLiabilities"`
+	LongTermDebt            float64 `json:"longTermDebt"`
+	OtherLiabilities        float64 `json:"otherLiabilities"`
+	MinorityInterest        float64 `json:"minorityInterest"`
+	TotalLiabilities        float64 `json:"totalLiabilities"`
+	CommonStock             float64 `json:"commonStock"`
+	RetainedEarnings        float64 `json:"retainedEarnings"`
+	TreasuryStock           float64 `json:"treasuryStock"`
+	CapitalSurplus          float64 `json:"capitalSurplus"`
+	ShareholderEquity       float64 `json:"shareholderEquity"`
+	NetTangibleAssets       float64 `json:"netTangibleAssets"`
+}
+
+//CashFlow https://iexcloud.io/docs/api/#cash-flow
+type CashFlow struct {
+	FiscalPeriod
+	NetIncome               float64 `json:"netIncome"`
+	Depreciation            float64 `json:"depreciation"`
+	ChangesInReceivables    float64 `json:"changesInReceivables"`
+	ChangesInInventories    float64 `json:"changesInInventories"`
+	CashChange              float64 `json:"cashChange"`
+	CashFlow                float64 `json:"cashFlow"`
+	CapitalExpenditures     float64 `json:"capitalExpenditures"`
+	Investments             float64 `json:"investments"`
+	InvestingActivityOther  float64 `json:"investingActivityOther"`
+	TotalInvestingCashFlows float64 `json:"totalInvestingCashFlows"`
+	DividendsPaid           float64 `json:"dividendsPaid"`
+	NetBorrowings           float64 `json:"netBorrowings"`
+	OtherFinancingCashFlows float64 `json:"otherFinancingCashFlows"`
+	CashFlowFinancing       float64 `json:"cashFlowFinancing"`
+	ExchangeRateEffect      float64 `json:"exchangeRateEffect"`
+}
+
+//Financials https://iexcloud.io/docs/api/#financials
+type Financials struct {
+	FiscalPeriod
+	GrossProfit            float64 `json:"grossProfit"`
+	CostOfRevenue          float64 `json:"costOfRevenue"`
+	OperatingRevenue       float64 `json:"operatingRevenue"`
+	TotalRevenue           float64 `json:"totalRevenue"`
+	OperatingIncome        float64 `json:"operatingIncome"`
+	NetIncome              float64 `json:"netIncome"`
+	ResearchAndDevelopment float64 `json:"researchAndDevelopment"`
+	OperatingExpense       float64 `json:"operatingExpense"`
+	CurrentAssets          float64 `json:"currentAssets"`
+	TotalAssets            float64 `json:"totalAssets"`
+	TotalLiabilities       float64 `json:"totalLiabilities"`
+	CurrentCash            float64 `json:"currentCash"`
+	CurrentDebt            float64 `json:"currentDebt"`
+	ShortTermDebt          float64 `json:"shortTermDebt"`
+	LongTermDebt           float64 `json:"longTermDebt"`
+	TotalCash              float64 `json:"totalCash"`
+	TotalDebt              float64 `json:"totalDebt"`
+	ShareholderEquity      float64 `json:"shareholderEquity"`
+	CashChange             float64 `json:"cashChange"`
+	CashFlow               float64 `json:"cashFlow"`
+}
+
+//Fundamentals income statement, balance sheet and cash flow reported for the same fiscal period.
+//A report is nil when IEX returned none for the period.
+type Fundamentals struct {
+	FiscalDate      string
+	ReportDate      string
+	IncomeStatement *IncomeStatement
+	BalanceSheet    *BalanceSheet
+	CashFlow        *CashFlow
+}
+
+type incomeStatements struct {
+	Symbol string             `json:"symbol"`
+	Income []*IncomeStatement `json:"income"`
+}
+
+type balanceSheets struct {
+	Symbol       string          `json:"symbol"`
+	BalanceSheet []*BalanceSheet `json:"balancesheet"`
+}
+
+type cashFlows struct {
+	Symbol   string      `json:"symbol"`
+	CashFlow []*CashFlow `json:"cashflow"`
+}
+
+type financials struct {
+	Symbol     string        `json:"symbol"`
+	Financials []*Financials `json:"financials"`
+}
+
+//Dividend https://iexcloud.io/docs/api/#dividends-basic
+type Dividend struct {
+	Symbol       string  `json:"symbol"`
+	ExDate       string  `json:"exDate"`
+	PaymentDate  string  `json:"paymentDate"`
+	RecordDate   string  `json:"recordDate"`
+	DeclaredDate string  `json:"declaredDate"`
+	Amount       float64 `json:"amount"`
+	Flag         string  `json:"flag"`
+	Currency     string  `json:"currency"`
+	Description  string  `json:"description"`
+	Frequency    string  `json:"frequency"`
+}
+
+//Split https://iexcloud.io/docs/api/#splits-basic
+//Ratio is FromFactor / ToFactor, e.g. 0.25 for a 4-for-1 split.
+type Split struct {
+	Symbol       string  `json:"symbol"`
+	ExDate       string  `json:"exDate"`
+	DeclaredDate string  `json:"declaredDate"`
+	Ratio        float64 `json:"ratio"`
+	ToFactor     float64 `json:"toFactor"`
+	FromFactor   float64 `json:"fromFactor"`
+	Description  string  `json:"description"`
+}
+
+//Earning https://iexcloud.io/docs/api/#earnings
+type Earning struct {
+	ActualEPS            float64 `json:"actualEPS"`
+	ConsensusEPS         float64 `json:"consensusEPS"`
+	AnnounceTime         string  `json:"announceTime"`
+	NumberOfEstimates    int     `json:"numberOfEstimates"`
+	EPSSurpriseDollar    float64 `json:"EPSSurpriseDollar"`
+	EPSReportDate        string  `json:"EPSReportDate"`
+	FiscalPeriod         string  `json:"fiscalPeriod"`
+	FiscalEndDate        string  `json:"fiscalEndDate"`
+	YearAgo              float64 `json:"yearAgo"`
+	YearAgoChangePercent float64 `json:"yearAgoChangePercent"`
+}
+
+//UpcomingEarning https://iexcloud.io/docs/api/#upcoming-events
+type UpcomingEarning struct {
+	Symbol        string `json:"symbol"`
+	ReportDate    string `json:"reportDate"`
+	FiscalPeriod  string `json:"fiscalPeriod"`
+	FiscalEndDate string `json:"fiscalEndDate"`
+}
+
+type earnings struct {
+	Symbol   string     `json:"symbol"`
+	Earnings []*Earning `json:"earnings"`
+}
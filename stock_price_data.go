@@ -65,6 +65,7 @@ type Quote struct {
 	PeRatio                float64    `json:"peRatio"`
 	LastTradeTime          EpochTime  `json:"lastTradeTime"`
 	IsUSMarketOpen         bool       `json:"isUSMarketOpen"`
+	Currency               string     `json:"currency"`
 }
 
 // BidAsk models a bid or an ask for a quote.
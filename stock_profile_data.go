@@ -1 +1,37 @@
 package iex
+
+//Company https://iexcloud.io/docs/api/#company
+type Company struct {
+	Symbol         string   `json:"symbol"`
+	CompanyName    string   `json:"companyName"`
+	Exchange       string   `json:"exchange"`
+	Industry       string   `json:"industry"`
+	Website        string   `json:"website"`
+	Description    string   `json:"description"`
+	CEO            string   `json:"CEO"`
+	SecurityName   string   `json:"securityName"`
+	IssueType      string   `json:"issueType"`
+	Sector         string   `json:"sector"`
+	PrimarySicCode int      `json:"primarySicCode"`
+	Employees      int      `json:"employees"`
+	Tags           []string `json:"tags"`
+	Address        string   `json:"address"`
+	Address2       string   `json:"address2"`
+	State          string   `json:"state"`
+	City           string   `json:"city"`
+	Zip            string   `json:"zip"`
+	Country        string   `json:"country"`
+	Phone          string   `json:"phone"`
+}
+
+//Logo https://iexcloud.io/docs/api/#logo
+type Logo struct {
+	URL string `json:"url"`
+}
+
+//InsiderRoster https://iexcloud.io/docs/api/#insider-roster
+type InsiderRoster struct {
+	EntityName string    `json:"entityName"`
+	Position   int       `json:"position"`
+	ReportDate EpochTime `json:"reportDate"`
+}
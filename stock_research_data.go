@@ -0,0 +1,237 @@
+package iex
+
+import (
+	"reflect"
+	"strings"
+)
+
+//keyStatNames stats accepted by Client.KeyStats, the json names of the KeyStats fields
+var keyStatNames = func() map[string]bool {
+	t := reflect.TypeOf(KeyStats{})
+	names := make(map[string]bool, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]; name != "" && name != "-" {
+			names[name] = true
+		}
+	}
+	return names
+}()
+
+//KeyStats https://iexcloud.io/docs/api/#key-stats
+type KeyStats struct {
+	CompanyName               string  `json:"companyName"`
+	Marketcap                 int64   `json:"marketcap"`
+	Week52High                float64 `json:"week52high"`
+	Week52Low                 float64 `json:"week52low"`
+	Week52HighSplitAdjustOnly float64 `json:"week52highSplitAdjustOnly"`
+	Week52LowSplitAdjustOnly  float64 `json:"week52lowSplitAdjustOnly"`
+	Week52Change              float64 `json:"week52change"`
+	SharesOutstanding         int64   `json:"sharesOutstanding"`
+	Float                     int64   `json:"float"`
+	Avg10Volume               float64 `json:"avg10Volume"`
+	Avg30Volume               float64 `json:"avg30Volume"`
+	Day200MovingAvg           float64 `json:"day200MovingAvg"`
+	Day50MovingAvg            float64 `json:"day50MovingAvg"`
+	Employees                 int     `json:"employees"`
+	TTMEPS                    float64 `json:"ttmEPS"`
+	TTMDividendRate           float64 `json:"ttmDividendRate"`
+	DividendYield             float64 `json:"dividendYield"`
+	NextDividendDate          string  `json:"nextDividendDate"`
+	ExDividendDate            string  `json:"exDividendDate"`
+	NextEarningsDate          string  `json:"nextEarningsDate"`
+	PeRatio                   float64 `json:"peRatio"`
+	Beta                      float64 `json:"beta"`
+	MaxChangePercent          float64 `json:"maxChangePercent"`
+	Year5ChangePercent        float64 `json:"year5ChangePercent"`
+	Year2ChangePercent        float64 `json:"year2ChangePercent"`
+	Year1ChangePercent        float64 `json:"year1ChangePercent"`
+	YtdChangePercent          float64 `json:"ytdChangePercent"`
+	Month6ChangePercent       float64 `json:"month6ChangePercent"`
+	Month3ChangePercent       float64 `json:"month3ChangePercent"`
+	Month1ChangePercent       float64 `json:"month1ChangePercent"`
+	Day30ChangePercent        float64 `json:"day30ChangePercent"`
+	Day5ChangePercent         float64 `json:"day5ChangePercent"`
+}
+
+//AdvancedStats https://iexcloud.io/docs/api/#advanced-stats
+type AdvancedStats struct {
+	KeyStats
+	TotalCash                float64 `json:"totalCash"`
+	CurrentDebt              float64 `json:"currentDebt"`
+	Revenue                  float64 `json:"revenue"`
+	GrossProfit              float64 `json:"grossProfit"`
+	TotalRevenue             float64 `json:"totalRevenue"`
+	EBITDA                   float64 `json:"EBITDA"`
+	RevenuePerShare          float64 `json:"revenuePerShare"`
+	RevenuePerEmployee       float64 `json:"revenuePerEmployee"`
+	DebtToEquity             float64 `json:"debtToEquity"`
+	ProfitMargin             float64 `json:"profitMargin"`
+	EnterpriseValue          float64 `json:"enterpriseValue"`
+	EnterpriseValueToRevenue float64 `json:"enterpriseValueToRevenue"`
+	PriceToSales             float64 `json:"priceToSales"`
+	PriceToBook              float64 `json:"priceToBook"`
+	ForwardPERatio           float64 `json:"forwardPERatio"`
+	PegRatio                 float64 `json:"pegRatio"`
+	PeHigh                   float64 `json:"peHigh"`
+	PeLow                    float64 `json:"peLow"`
+	Week52HighDate           string  `json:"week52highDate"`
+	Week52LowDate            string  `json:"week52lowDate"`
+	PutCallRatio             float64 `json:"putCallRatio"`
+}
+
+//EnterpriseValueToEBITDA EV/EBITDA, 0 when EBITDA is unknown
+func (a *AdvancedStats) EnterpriseValueToEBITDA() float64 {
+	if a.EBITDA == 0 {
+		return 0
+	}
+	return a.EnterpriseValue / a.EBITDA
+}
+
+//IPOCalendar https://iexcloud.io/docs/api/#ipo-calendar
+type IPOCalendar struct {
+	RawData  []*IPO     `json:"rawData"`
+	ViewData []*IPOView `json:"viewData"`
+}
+
+//IPO raw data of an upcoming IPO
+type IPO struct {
+	Symbol                 string   `json:"symbol"`
+	CompanyName            string   `json:"companyName"`
+	ExpectedDate           string   `json:"expectedDate"`
+	LeadUnderwriters       []string `json:"leadUnderwriters"`
+	Underwriters           []string `json:"underwriters"`
+	CompanyCounsel         []string `json:"companyCounsel"`
+	UnderwriterCounsel     []string `json:"underwriterCounsel"`
+	Auditor                string   `json:"auditor"`
+	Market                 string   `json:"market"`
+	CIK                    string   `json:"cik"`
+	Address                string   `json:"address"`
+	City                   string   `json:"city"`
+	State                  string   `json:"state"`
+	Zip                    string   `json:"zip"`
+	Phone                  string   `json:"phone"`
+	CEO                    string   `json:"ceo"`
+	Employees              int      `json:"employees"`
+	URL                    string   `json:"url"`
+	Status                 string   `json:"status"`
+	SharesOffered          int64    `json:"sharesOffered"`
+	PriceLow               float64  `json:"priceLow"`
+	PriceHigh              float64  `json:"priceHigh"`
+	OfferAmount            float64  `json:"offerAmount"`
+	TotalExpenses          float64  `json:"totalExpenses"`
+	SharesOverAlloted      int64    `json:"sharesOverAlloted"`
+	ShareholderShares      int64    `json:"shareholderShares"`
+	SharesOutstanding      int64    `json:"sharesOutstanding"`
+	LockupPeriodExpiration string   `json:"lockupPeriodExpiration"`
+	QuietPeriodExpiration  string   `json:"quietPeriodExpiration"`
+	Revenue                float64  `json:"revenue"`
+	NetIncome              float64  `json:"netIncome"`
+	TotalAssets            float64  `json:"totalAssets"`
+	TotalLiabilities       float64  `json:"totalLiabilities"`
+	StockholderEquity      float64  `json:"stockholderEquity"`
+	CompanyDescription     string   `json:"companyDescription"`
+	BusinessDescription    string   `json:"businessDescription"`
+	UseOfProceeds          string   `json:"useOfProceeds"`
+	Competition            string   `json:"competition"`
+	Amount                 float64  `json:"amount"`
+	PercentOffered         string   `json:"percentOffered"`
+}
+
+//IPOView display formatted data of an upcoming IPO
+type IPOView struct {
+	Company  string `json:"Company"`
+	Symbol   string `json:"Symbol"`
+	Price    string `json:"Price"`
+	Shares   string `json:"Shares"`
+	Amount   string `json:"Amount"`
+	Float    string `json:"Float"`
+	Percent  string `json:"Percent"`
+	Market   string `json:"Market"`
+	Expected string `json:"Expected"`
+}
+
+//Estimate https://iexcloud.io/docs/api/#estimates
+type Estimate struct {
+	ConsensusEPS      float64 `json:"consensusEPS"`
+	AnnounceTime      string  `json:"announceTime"`
+	NumberOfEstimates int     `json:"numberOfEstimates"`
+	ReportDate        string  `json:"reportDate"`
+	FiscalPeriod      string  `json:"fiscalPeriod"`
+	FiscalEndDate     string  `json:"fiscalEndDate"`
+	Currency          string  `json:"currency"`
+}
+
+//PriceTarget https://iexcloud.io/docs/api/#price-target
+type PriceTarget struct {
+	Symbol             string  `json:"symbol"`
+	UpdatedDate        string  `json:"updatedDate"`
+	PriceTargetAverage float64 `json:"priceTargetAverage"`
+	PriceTargetHigh    float64 `json:"priceTargetHigh"`
+	PriceTargetLow     float64 `json:"priceTargetLow"`
+	NumberOfAnalysts   int     `json:"numberOfAnalysts"`
+	Currency           string  `json:"currency"`
+}
+
+//FundOwnership https://iexcloud.io/docs/api/#fund-ownership
+type FundOwnership struct {
+	AdjHolding       float64   `json:"adjHolding"`
+	AdjMv            float64   `json:"adjMv"`
+	EntityProperName string    `json:"entityProperName"`
+	ReportDate       EpochTime `json:"reportDate"`
+	ReportedHolding  float64   `json:"reportedHolding"`
+	ReportedMv       float64   `json:"reportedMv"`
+}
+
+//InstitutionalOwnership https://iexcloud.io/docs/api/#institutional-ownership
+type InstitutionalOwnership struct {
+	AdjHolding       float64   `json:"adjHolding"`
+	AdjMv            float64   `json:"adjMv"`
+	EntityProperName string    `json:"entityProperName"`
+	ReportDate       EpochTime `json:"reportDate"`
+	FilingDate       string    `json:"filingDate"`
+	ReportedHolding  float64   `json:"reportedHolding"`
+}
+
+//InsiderTransaction https://iexcloud.io/docs/api/#insider-transactions
+type InsiderTransaction struct {
+	EffectiveDate             EpochTime `json:"effectiveDate"`
+	FullName                  string    `json:"fullName"`
+	ReportedTitle             string    `json:"reportedTitle"`
+	ConversionOrExercisePrice float64   `json:"conversionOrExercisePrice"`
+	DirectIndirect            string    `json:"directIndirect"`
+	FilingDate                string    `json:"filingDate"`
+	PostShares                int64     `json:"postShares"`
+	TransactionDate           string    `json:"transactionDate"`
+	TranCode                  string    `json:"tranCode"`
+	TranPrice                 float64   `json:"tranPrice"`
+	TranShares                int64     `json:"tranShares"`
+	TranValue                 float64   `json:"tranValue"`
+}
+
+//InsiderSummary https://iexcloud.io/docs/api/#insider-summary
+type InsiderSummary struct {
+	FullName      string `json:"fullName"`
+	NetTransacted int64  `json:"netTransacted"`
+	ReportedTitle string `json:"reportedTitle"`
+	TotalBought   int64  `json:"totalBought"`
+	TotalSold     int64  `json:"totalSold"`
+}
+
+//RecommendationTrend https://iexcloud.io/docs/api/#recommendation-trends
+type RecommendationTrend struct {
+	ConsensusEndDate            EpochTime `json:"consensusEndDate"`
+	ConsensusStartDate          EpochTime `json:"consensusStartDate"`
+	CorporateActionsAppliedDate EpochTime `json:"corporateActionsAppliedDate"`
+	RatingBuy                   int       `json:"ratingBuy"`
+	RatingHold                  int       `json:"ratingHold"`
+	RatingNone                  int       `json:"ratingNone"`
+	RatingOverweight            int       `json:"ratingOverweight"`
+	RatingScaleMark             float64   `json:"ratingScaleMark"`
+	RatingSell                  int       `json:"ratingSell"`
+	RatingUnderweight           int       `json:"ratingUnderweight"`
+}
+
+type estimates struct {
+	Symbol    string      `json:"symbol"`
+	Estimates []*Estimate `json:"estimates"`
+}
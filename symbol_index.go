@@ -0,0 +1,76 @@
+package iex
+
+import (
+	"sort"
+	"strings"
+)
+
+//SymbolIndex in-memory index of reference symbols, e.g. from Client.Symbols, to validate symbols without API calls.
+//Lookups are case-insensitive. It is safe for concurrent reads.
+type SymbolIndex struct {
+	bySymbol   map[string]*Symbol
+	byExchange map[string][]*Symbol
+	byType     map[string][]*Symbol
+	byName     []*Symbol
+}
+
+//NewSymbolIndex index symbols. For duplicate symbols the last one wins.
+func NewSymbolIndex(symbols []*Symbol) *SymbolIndex {
+	idx := &SymbolIndex{
+		bySymbol:   make(map[string]*Symbol, len(symbols)),
+		byExchange: make(map[string][]*Symbol),
+		byType:     make(map[string][]*Symbol),
+	}
+	for _, s := range symbols {
+		idx.bySymbol[strings.ToUpper(s.Symbol)] = s
+	}
+	for _, s := range symbols {
+		if idx.bySymbol[strings.ToUpper(s.Symbol)] != s {
+			continue
+		}
+		idx.byExchange[strings.ToUpper(s.Exchange)] = append(idx.byExchange[strings.ToUpper(s.Exchange)], s)
+		idx.byType[strings.ToLower(s.Type)] = append(idx.byType[strings.ToLower(s.Type)], s)
+		idx.byName = append(idx.byName, s)
+	}
+	sort.SliceStable(idx.byName, func(i, j int) bool {
+		return strings.ToLower(idx.byName[i].Name) < strings.ToLower(idx.byName[j].Name)
+	})
+	return idx
+}
+
+//Len number of indexed symbols
+func (o *SymbolIndex) Len() int {
+	return len(o.bySymbol)
+}
+
+//Lookup find symbol
+func (o *SymbolIndex) Lookup(symbol string) (*Symbol, bool) {
+	s, ok := o.bySymbol[strings.ToUpper(symbol)]
+	return s, ok
+}
+
+//ByNamePrefix symbols whose name starts with prefix, ordered by name
+func (o *SymbolIndex) ByNamePrefix(prefix string) []*Symbol {
+	prefix = strings.ToLower(prefix)
+	start := sort.Search(len(o.byName), func(i int) bool {
+		return strings.ToLower(o.byName[i].Name) >= prefix
+	})
+	var ret []*Symbol
+	for _, s := range o.byName[start:] {
+		if !strings.HasPrefix(strings.ToLower(s.Name), prefix) {
+			break
+		}
+		ret = append(ret, s)
+	}
+	return ret
+}
+
+//ByExchange symbols listed on exchange, e.g. NAS
+func (o *SymbolIndex) ByExchange(exchange string) []*Symbol {
+	return o.byExchange[strings.ToUpper(exchange)]
+}
+
+//ByType symbols of an issue type, e.g. cs for common stock or et for ETF
+func (o *SymbolIndex) ByType(symbolType string) []*Symbol {
+	return o.byType[strings.ToLower(symbolType)]
+}
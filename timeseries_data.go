@@ -0,0 +1,9 @@
+package iex
+
+//DataPoint https://iexcloud.io/docs/api/#data-points
+type DataPoint struct {
+	Key         string `json:"key"`
+	Weight      int    `json:"weight"`
+	Description string `json:"description"`
+	LastUpdated string `json:"lastUpdated"`
+}